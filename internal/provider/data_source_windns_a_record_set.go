@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDnsARecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsARecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateName,
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      hashIPString,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsARecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadARecord(&windns.ReadARecordOptions{
+		Name:      resourceRecordName(d),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	// a name that does not exist has no addresses
+	ttl := 0
+	addresses := schema.NewSet(hashIPString, nil)
+	if len(rsp.Records) > 0 {
+		var ttls sort.IntSlice
+		for _, record := range rsp.Records {
+			addresses.Add(record.Data)
+			ttls = append(ttls, record.TTL)
+		}
+		sort.Sort(ttls)
+		ttl = ttls[0]
+	}
+
+	d.SetId(resourceRecordID(d))
+	d.Set("addresses", addresses)
+	d.Set("ttl", ttl)
+	return nil
+}
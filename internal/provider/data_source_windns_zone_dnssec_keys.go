@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDnsZoneDnsSecKeys() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsZoneDnsSecKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateZone,
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"protocol": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"key_tag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"public_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dnskey": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ds_sha256": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ds_sha384": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ds_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDnsZoneDnsSecKeysRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	zone := d.Get("zone").(string)
+	rsp, err := client.ReadDNSKEY(&windns.ReadDNSKEYOptions{
+		ZoneName: zone,
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	// the DS records to publish in the parent are those of the keys
+	// flagged as secure entry points, the key signing keys
+	keys := []map[string]interface{}{}
+	dsRecords := []string{}
+	for _, key := range rsp.DnsKeys {
+		algorithm, err := key.AlgorithmNumber()
+		if err != nil {
+			return err
+		}
+		tag, err := key.KeyTag()
+		if err != nil {
+			return err
+		}
+		sha256, err := key.DS(zone, windns.DigestSHA256)
+		if err != nil {
+			return err
+		}
+		sha384, err := key.DS(zone, windns.DigestSHA384)
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, map[string]interface{}{
+			"flags":      key.Flags,
+			"protocol":   key.Protocol,
+			"algorithm":  int(algorithm),
+			"key_tag":    int(tag),
+			"public_key": key.PublicKey,
+			"dnskey":     key.String(),
+			"ds_sha256":  sha256,
+			"ds_sha384":  sha384,
+			"ttl":        key.TTL,
+		})
+
+		if key.PublishDS() {
+			dsRecords = append(dsRecords, sha256, sha384)
+		}
+	}
+
+	d.SetId(zone)
+	d.Set("keys", keys)
+	d.Set("ds_records", dsRecords)
+	return nil
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsZoneRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRecordType,
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceDnsZoneRecordFields flattens typed record data into a map of
+// its fields, character strings are joined into a single value
+func dataSourceDnsZoneRecordFields(data interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if _, ok := data.(string); ok || data == nil {
+		return fields, nil
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+
+	for k, v := range values {
+		switch v := v.(type) {
+		case []interface{}:
+			parts := []string{}
+			for _, part := range v {
+				parts = append(parts, fmt.Sprint(part))
+			}
+			fields[k] = strings.Join(parts, "")
+		default:
+			fields[k] = fmt.Sprint(v)
+		}
+	}
+	return fields, nil
+}
+
+func dataSourceDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	zone := d.Get("zone").(string)
+	client := meta.(*windns.Client)
+	rsp, err := client.ListRecords(&windns.ListRecordsOptions{
+		ZoneName:   zone,
+		ZoneScope:  d.Get("zone_scope").(string),
+		NamePrefix: d.Get("name_prefix").(string),
+		Types:      setToStrings(d.Get("types").(*schema.Set)),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	records := []interface{}{}
+	for _, record := range rsp.Records {
+		fields, err := dataSourceDnsZoneRecordFields(record.Data)
+		if err != nil {
+			return fmt.Errorf("Error reading %s record %s: %s", record.Type, record.Name, err)
+		}
+
+		fqdn := zone
+		if record.Name != "@" {
+			fqdn = fmt.Sprintf("%s.%s", record.Name, zone)
+		}
+
+		records = append(records, map[string]interface{}{
+			"name":      record.Name,
+			"fqdn":      fqdn,
+			"type":      record.Type,
+			"data":      fmt.Sprint(record.Data),
+			"fields":    fields,
+			"ttl":       record.TTL,
+			"timestamp": record.Timestamp,
+		})
+	}
+
+	d.SetId(zone)
+	if err := d.Set("records", records); err != nil {
+		return fmt.Errorf("Error setting records: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceDnsZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Primary", "Secondary", "Stub", "Forwarder"}, false),
+			},
+			"reverse": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ad_integrated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"dynamic_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"None", "Secure", "NonsecureAndSecure"}, false),
+			},
+			"replication_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Forest", "Domain", "Legacy", "Custom"}, false),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reverse": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ad_integrated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"dynamic_update": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replication_scope": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceDnsZonesMatch reports whether a zone passes all of the
+// configured filters, unset filters match any zone
+func dataSourceDnsZonesMatch(d *schema.ResourceData, zone *windns.Zone) bool {
+	if v, ok := d.GetOk("type"); ok && !strings.EqualFold(v.(string), zone.Type) {
+		return false
+	}
+	// GetOkExists tells an explicit false apart from an unset filter
+	if v, ok := d.GetOkExists("reverse"); ok && v.(bool) != zone.IsReverse {
+		return false
+	}
+	if v, ok := d.GetOkExists("ad_integrated"); ok && v.(bool) != zone.IsDsIntegrated {
+		return false
+	}
+	if v, ok := d.GetOk("dynamic_update"); ok && !strings.EqualFold(v.(string), zone.DynamicUpdate) {
+		return false
+	}
+	if v, ok := d.GetOk("replication_scope"); ok && !strings.EqualFold(v.(string), zone.ReplicationScope) {
+		return false
+	}
+	return true
+}
+
+func dataSourceDnsZonesRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ListZones(&windns.ListZonesOptions{})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	matched := []*windns.Zone{}
+	for _, zone := range rsp.Zones {
+		if dataSourceDnsZonesMatch(d, zone) {
+			matched = append(matched, zone)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return strings.ToLower(matched[i].Name) < strings.ToLower(matched[j].Name)
+	})
+
+	names := []string{}
+	zones := []interface{}{}
+	for _, zone := range matched {
+		name := strings.TrimSuffix(zone.Name, ".") + "."
+		names = append(names, name)
+		zones = append(zones, map[string]interface{}{
+			"name":              name,
+			"type":              zone.Type,
+			"reverse":           zone.IsReverse,
+			"ad_integrated":     zone.IsDsIntegrated,
+			"dynamic_update":    zone.DynamicUpdate,
+			"replication_scope": zone.ReplicationScope,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcodeString(strings.Join(names, ","))))
+	d.Set("names", names)
+	if err := d.Set("zones", zones); err != nil {
+		return fmt.Errorf("Error setting zones: %s", err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"hash/crc32"
 	"net"
+	"strings"
 )
 
 // Credits
@@ -39,6 +40,9 @@ func hashcodeStrings(strings []string) string {
 }
 */
 
+// hashIPString hashes the canonical form of an ip address so that
+// equivalent spellings such as 2001:db8::1 and 2001:0db8:0:0:0:0:0:1
+// hash to the same set element.
 func hashIPString(v interface{}) int {
 	addr := v.(string)
 	ip := net.ParseIP(addr)
@@ -47,3 +51,8 @@ func hashIPString(v interface{}) int {
 	}
 	return hashcodeString(ip.String())
 }
+
+// hashFQDNString hashes a host name ignoring case and the trailing dot
+func hashFQDNString(v interface{}) int {
+	return hashcodeString(strings.ToLower(strings.TrimSuffix(v.(string), ".")))
+}
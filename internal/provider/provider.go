@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/http"
+	"strings"
 
 	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -76,7 +81,38 @@ func New() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"windns_a_record_set": resourceDnsARecordSet(),
+			"windns_a_record_set":          resourceDnsARecordSet(),
+			"windns_aaaa_record_set":       resourceDnsAAAARecordSet(),
+			"windns_cname_record":          resourceDnsCNAMERecord(),
+			"windns_ptr_record":            resourceDnsPTRRecord(),
+			"windns_mx_record_set":         resourceDnsMXRecordSet(),
+			"windns_txt_record_set":        resourceDnsTXTRecordSet(),
+			"windns_srv_record_set":        resourceDnsSRVRecordSet(),
+			"windns_ns_record_set":         resourceDnsNSRecordSet(),
+			"windns_record":                resourceDnsRecord(),
+			"windns_naptr_record_set":      resourceDnsNAPTRRecordSet(),
+			"windns_rp_record_set":         resourceDnsRPRecordSet(),
+			"windns_afsdb_record_set":      resourceDnsAFSDBRecordSet(),
+			"windns_hinfo_record_set":      resourceDnsHINFORecordSet(),
+			"windns_dname_record":          resourceDnsDNAMERecord(),
+			"windns_primary_zone":          resourceDnsPrimaryZone(),
+			"windns_secondary_zone":        resourceDnsSecondaryZone(),
+			"windns_stub_zone":             resourceDnsStubZone(),
+			"windns_conditional_forwarder": resourceDnsConditionalForwarder(),
+			"windns_zone_transfer":         resourceDnsZoneTransfer(),
+			"windns_zone_aging":            resourceDnsZoneAging(),
+			"windns_zone_signing":          resourceDnsZoneSigning(),
+			"windns_zone_scope":            resourceDnsZoneScope(),
+			"windns_zone_delegation":       resourceDnsZoneDelegation(),
+			"windns_zone_soa":              resourceDnsZoneSOA(),
+			"windns_server_forwarders":     resourceDnsServerForwarders(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			"windns_a_record_set":     dataSourceDnsARecordSet(),
+			"windns_zone_dnssec_keys": dataSourceDnsZoneDnsSecKeys(),
+			"windns_zones":            dataSourceDnsZones(),
+			"windns_zone_records":     dataSourceDnsZoneRecords(),
 		},
 
 		ConfigureFunc: configureProvider,
@@ -119,73 +155,100 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	return windns.NewClient(opts)
 }
 
-// https://github.com/hashicorp/terraform-provider-dns/blob/main/internal/provider/provider.go
+// resourceDnsImport imports a record resource by the id it is created
+// with, <fqdn> or <fqdn>/<zone_scope>. The zone is the longest zone on the
+// server the fqdn belongs to
 func resourceDnsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	/*
-			record := d.Id()
-			if !IsFqdn(record) {
-				return nil, fmt.Errorf("Not a fully-qualified DNS name: %s", record)
-			}
-
-			labels := dns.SplitDomainName(record)
-
-			msg := new(dns.Msg)
-
-			var zone *string
-
-		Loop:
-			for l := range labels {
-
-				msg.SetQuestion(dns.Fqdn(strings.Join(labels[l:], ".")), dns.TypeSOA)
-
-				r, err := exchange(msg, true, meta)
-				if err != nil {
-					return nil, fmt.Errorf("Error querying DNS record: %s", err)
-				}
-
-				switch r.Rcode {
-				case dns.RcodeSuccess:
-
-					if len(r.Answer) == 0 {
-						continue
-					}
-
-					for _, ans := range r.Answer {
-						switch t := ans.(type) {
-						case *dns.SOA:
-							zone = &t.Hdr.Name
-						case *dns.CNAME:
-							continue Loop
-						}
-					}
-
-					break Loop
-				case dns.RcodeNameError:
-					continue
-				default:
-					return nil, fmt.Errorf("Error querying DNS record: %v (%s)", r.Rcode, dns.RcodeToString[r.Rcode])
-				}
-			}
-
-			if zone == nil {
-				return nil, fmt.Errorf("No SOA record in authority section in response for %s", record)
-			}
-
-			common := dns.CompareDomainName(record, *zone)
-			if common == 0 {
-				return nil, fmt.Errorf("DNS record %s shares no common labels with zone %s", record, *zone)
-			}
-
-			d.Set("zone", *zone)
-			if name := strings.Join(labels[:len(labels)-common], "."); name != "" {
-				d.Set("name", name)
-			}
-
-			return []*schema.ResourceData{d}, nil
-	*/
+	if meta == nil {
+		return nil, fmt.Errorf("client not created")
+	}
+
+	fqdn, scope, err := parseRecordID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resourceDnsImportFQDN(d, meta.(*windns.Client), fqdn, scope); err != nil {
+		return nil, err
+	}
+
+	d.SetId(resourceRecordID(d))
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceDnsImportFQDN sets the zone, name and zone scope of a record
+// resource being imported
+func resourceDnsImportFQDN(d *schema.ResourceData, client *windns.Client, fqdn, scope string) error {
+	rsp, err := client.ListZones(&windns.ListZonesOptions{})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	zones := []string{}
+	for _, zone := range rsp.Zones {
+		zones = append(zones, zone.Name)
+	}
+
+	zone, name, err := splitRecordFQDN(fqdn, zones)
+	if err != nil {
+		return err
+	}
+
+	d.Set("zone", zone)
+	if name != "" {
+		d.Set("name", name)
+	}
+	if scope != "" {
+		d.Set("zone_scope", scope)
+	}
+	return nil
+}
+
+// parseRecordID splits an id built by resourceRecordID into the fqdn and the
+// zone scope, which is empty for the default scope
+func parseRecordID(id string) (fqdn string, scope string, err error) {
+	parts := strings.SplitN(id, "/", 2)
+	fqdn = parts[0]
+	if len(parts) == 2 {
+		scope = parts[1]
+		if scope == "" || strings.Contains(scope, "/") {
+			return "", "", fmt.Errorf("Not a valid record id, expected <fqdn>[/<zone_scope>]: %s", id)
+		}
+	}
+
+	if !IsFqdn(fqdn) {
+		return "", "", fmt.Errorf("Not a fully-qualified DNS name: %s", fqdn)
+	}
+	return fqdn, scope, nil
+}
+
+// splitRecordFQDN returns the longest of the zones the fqdn belongs to, fully
+// qualified, and the name of the record relative to it. The name is empty
+// for the zone apex
+func splitRecordFQDN(fqdn string, zones []string) (zone string, name string, err error) {
+	owner := strings.TrimSuffix(fqdn, ".")
+	for _, z := range zones {
+		candidate := strings.TrimSuffix(z, ".")
+		if !strings.EqualFold(owner, candidate) && !strings.HasSuffix(strings.ToLower(owner), "."+strings.ToLower(candidate)) {
+			continue
+		}
+		if len(candidate) > len(strings.TrimSuffix(zone, ".")) {
+			zone = candidate + "."
+		}
+	}
+
+	if zone == "" {
+		return "", "", fmt.Errorf("no zone for %s found on the server", fqdn)
+	}
+
+	if len(owner) == len(zone)-1 {
+		return zone, "", nil
+	}
+	return zone, owner[:len(owner)-len(zone)], nil
+}
+
 func resourceFQDN(d *schema.ResourceData) string {
 
 	fqdn := d.Get("zone").(string)
@@ -196,3 +259,87 @@ func resourceFQDN(d *schema.ResourceData) string {
 
 	return fqdn
 }
+
+// resourceRecordID returns the id of a record resource, records of a zone
+// scope carry the scope after the fqdn so that the same name in different
+// scopes gets distinct ids
+func resourceRecordID(d *schema.ResourceData) string {
+	id := resourceFQDN(d)
+	if scope, ok := d.GetOk("zone_scope"); ok {
+		id = fmt.Sprintf("%s/%s", id, scope.(string))
+	}
+	return id
+}
+
+// resourceRecordName returns the record name relative to the zone, an
+// omitted name refers to the zone apex
+func resourceRecordName(d *schema.ResourceData) string {
+	if name, ok := d.GetOk("name"); ok {
+		return name.(string)
+	}
+	return "@"
+}
+
+// suppressFQDNDiff ignores differences in case and the trailing dot
+// between host names, the server always returns fully qualified names
+func suppressFQDNDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.EqualFold(strings.TrimSuffix(old, "."), strings.TrimSuffix(new, "."))
+}
+
+// forceNewOnStorageChange forces a new zone when replication_scope is added
+// or removed. Stub zones and conditional forwarders can not be converted
+// between file backed and active directory integrated storage in place
+var forceNewOnStorageChange = customdiff.ForceNewIfChange("replication_scope", func(ctx context.Context, old, new, meta interface{}) bool {
+	return old.(string) == "" || new.(string) == ""
+})
+
+// suppressIPDiff ignores differences in the spelling of equivalent ip
+// addresses, the server always returns the canonical form
+func suppressIPDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldIP, newIP := net.ParseIP(old), net.ParseIP(new)
+	return oldIP != nil && oldIP.Equal(newIP)
+}
+
+// resourceDnsZoneImport imports a zone resource by its zone name
+func resourceDnsZoneImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name := d.Id()
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDnsZoneMasterServers returns the configured master servers of a
+// zone in order
+func resourceDnsZoneMasterServers(d *schema.ResourceData) []string {
+	masters := []string{}
+	for _, master := range d.Get("master_servers").([]interface{}) {
+		masters = append(masters, master.(string))
+	}
+	return masters
+}
+
+// resourceDnsZoneSettingsImport imports a resource managing the settings
+// of an existing zone by its zone name
+func resourceDnsZoneSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zone := d.Id()
+	if !strings.HasSuffix(zone, ".") {
+		zone += "."
+	}
+
+	d.SetId(zone)
+	d.Set("zone", zone)
+	return []*schema.ResourceData{d}, nil
+}
+
+// setToStrings returns the string elements of a set
+func setToStrings(set *schema.Set) []string {
+	values := []string{}
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+	return values
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestRecordIDRoundTrip(t *testing.T) {
+	zones := []string{"example.com", "sub.example.com", "example.org."}
+	cases := []struct {
+		raw  map[string]interface{}
+		id   string
+		zone string
+		name string
+	}{
+		{map[string]interface{}{"zone": "example.com."}, "example.com.", "example.com.", ""},
+		{map[string]interface{}{"zone": "example.com.", "name": "www"}, "www.example.com.", "example.com.", "www"},
+		{map[string]interface{}{"zone": "sub.example.com.", "name": "a.b"}, "a.b.sub.example.com.", "sub.example.com.", "a.b"},
+		{map[string]interface{}{"zone": "example.org.", "name": "www", "zone_scope": "europe"}, "www.example.org./europe", "example.org.", "www"},
+		{map[string]interface{}{"zone": "example.com.", "zone_scope": "europe"}, "example.com./europe", "example.com.", ""},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceDnsARecordSet().Schema, c.raw)
+		id := resourceRecordID(d)
+		if id != c.id {
+			t.Errorf("resourceRecordID(%v) = %q, want %q", c.raw, id, c.id)
+			continue
+		}
+
+		fqdn, scope, err := parseRecordID(id)
+		if err != nil {
+			t.Errorf("parseRecordID(%q): %s", id, err)
+			continue
+		}
+		zone, name, err := splitRecordFQDN(fqdn, zones)
+		if err != nil {
+			t.Errorf("splitRecordFQDN(%q): %s", fqdn, err)
+			continue
+		}
+
+		wantScope, _ := c.raw["zone_scope"].(string)
+		if zone != c.zone || name != c.name || scope != wantScope {
+			t.Errorf("%q parsed into zone %q, name %q, scope %q, want %q, %q, %q", id, zone, name, scope, c.zone, c.name, wantScope)
+		}
+	}
+}
+
+func TestParseRecordIDInvalid(t *testing.T) {
+	for _, id := range []string{"www.example.com", "www.example.com./", "www.example.com./a/b"} {
+		if _, _, err := parseRecordID(id); err == nil {
+			t.Errorf("parseRecordID(%q) succeeded", id)
+		}
+	}
+
+	if _, _, err := splitRecordFQDN("www.example.net.", []string{"example.com"}); err == nil {
+		t.Error("splitRecordFQDN outside of the zones succeeded")
+	}
+}
+
+func TestDnsRecordIDRoundTrip(t *testing.T) {
+	cases := []struct {
+		raw  map[string]interface{}
+		data string
+	}{
+		{map[string]interface{}{"zone": "example.com.", "name": "www", "type": "A", "data": "192.0.2.1"}, "192.0.2.1"},
+		{map[string]interface{}{"zone": "example.com.", "name": "www", "type": "TXT", "data": `"a/MX/b"`}, `"a/MX/b"`},
+		{map[string]interface{}{"zone": "example.com.", "zone_scope": "europe", "type": "MX", "data": "10 mail.example.com."}, "10 mail.example.com."},
+		{map[string]interface{}{"zone": "example.com.", "zone_scope": "A", "type": "A", "data": "192.0.2.1"}, "192.0.2.1"},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceDnsRecord().Schema, c.raw)
+		recordID := resourceRecordID(d)
+		id := fmt.Sprintf("%s/%s/%s", recordID, c.raw["type"], c.data)
+
+		gotID, recordType, data, err := parseDnsRecordID(id)
+		if err != nil {
+			t.Errorf("parseDnsRecordID(%q): %s", id, err)
+			continue
+		}
+		if gotID != recordID || recordType != c.raw["type"] || data != c.data {
+			t.Errorf("parseDnsRecordID(%q) = %q, %q, %q", id, gotID, recordType, data)
+		}
+	}
+}
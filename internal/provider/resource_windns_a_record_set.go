@@ -15,11 +15,9 @@ func resourceDnsARecordSet() *schema.Resource {
 		Read:   resourceDnsARecordSetRead,
 		Update: resourceDnsARecordSetUpdate,
 		Delete: resourceDnsARecordSetDelete,
-		/*
-			Importer: &schema.ResourceImporter{
-				State: resourceDnsImport,
-			},
-		*/
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"zone": {
@@ -28,6 +26,11 @@ func resourceDnsARecordSet() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validateZone,
 			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -56,7 +59,7 @@ func resourceDnsARecordSetCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	client := meta.(*windns.Client)
-	d.SetId(resourceFQDN(d))
+	d.SetId(resourceRecordID(d))
 	name := d.Get("name").(string)
 	zone := d.Get("zone").(string)
 	ttl := d.Get("ttl").(int)
@@ -64,10 +67,11 @@ func resourceDnsARecordSetCreate(d *schema.ResourceData, meta interface{}) error
 
 	for _, address := range addresses {
 		rsp, err := client.AddARecord(&windns.AddARecordOptions{
-			Name:     name,
-			Address:  address.(string),
-			ZoneName: zone,
-			TTL:      ttl,
+			Name:      name,
+			Address:   address.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       ttl,
 		})
 		if err != nil {
 			d.SetId("")
@@ -88,8 +92,9 @@ func resourceDnsARecordSetRead(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*windns.Client)
 	rsp, err := client.ReadARecord(&windns.ReadARecordOptions{
-		Name:     d.Get("name").(string),
-		ZoneName: d.Get("zone").(string),
+		Name:      d.Get("name").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
 	})
 	if err != nil {
 		d.SetId("")
@@ -137,9 +142,10 @@ func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 		// Loop through all the old addresses and remove them
 		for _, addr := range remove {
 			rsp, err := client.DeleteARecord(&windns.DeleteARecordOptions{
-				Name:     name,
-				ZoneName: zone,
-				Address:  addr.(string),
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Address:   addr.(string),
 			})
 			if err != nil {
 				d.SetId("")
@@ -152,10 +158,11 @@ func resourceDnsARecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 		// Loop through all the new addresses and insert them
 		for _, addr := range add {
 			rsp, err := client.AddARecord(&windns.AddARecordOptions{
-				Name:     name,
-				ZoneName: zone,
-				Address:  addr.(string),
-				TTL:      ttl,
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Address:   addr.(string),
+				TTL:       ttl,
 			})
 			if err != nil {
 				d.SetId("")
@@ -182,9 +189,10 @@ func resourceDnsARecordSetDelete(d *schema.ResourceData, meta interface{}) error
 
 	for _, address := range addresses {
 		rsp, err := client.DeleteARecord(&windns.DeleteARecordOptions{
-			Name:     name,
-			Address:  address.(string),
-			ZoneName: zone,
+			Name:      name,
+			Address:   address.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
 		})
 		if err != nil {
 			d.SetId("")
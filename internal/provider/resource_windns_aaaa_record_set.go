@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsAAAARecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsAAAARecordSetCreate,
+		Read:   resourceDnsAAAARecordSetRead,
+		Update: resourceDnsAAAARecordSetUpdate,
+		Delete: resourceDnsAAAARecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"addresses": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPv6Address,
+				},
+				Set: hashIPString,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsAAAARecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	addresses := d.Get("addresses").(*schema.Set).List()
+
+	for _, address := range addresses {
+		rsp, err := client.AddAAAARecord(&windns.AddAAAARecordOptions{
+			Name:      name,
+			Address:   address.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsAAAARecordSetRead(d, meta)
+}
+
+func resourceDnsAAAARecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadAAAARecord(&windns.ReadAAAARecordOptions{
+		Name:      d.Get("name").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		d.SetId("")
+		return err
+	} else if rsp.Code != http.StatusOK {
+		d.SetId("")
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		addresses := schema.NewSet(hashIPString, nil)
+		for _, record := range rsp.Records {
+			addresses.Add(record.Data)
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set("addresses", addresses)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsAAAARecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange("addresses") {
+		o, n := d.GetChange("addresses")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old addresses and remove them
+		for _, addr := range remove {
+			rsp, err := client.DeleteAAAARecord(&windns.DeleteAAAARecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Address:   addr.(string),
+			})
+			if err != nil {
+				d.SetId("")
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				d.SetId("")
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new addresses and insert them
+		for _, addr := range add {
+			rsp, err := client.AddAAAARecord(&windns.AddAAAARecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Address:   addr.(string),
+				TTL:       ttl,
+			})
+			if err != nil {
+				d.SetId("")
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				d.SetId("")
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsAAAARecordSetRead(d, meta)
+}
+
+func resourceDnsAAAARecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+	addresses := d.Get("addresses").(*schema.Set).List()
+
+	for _, address := range addresses {
+		rsp, err := client.DeleteAAAARecord(&windns.DeleteAAAARecordOptions{
+			Name:      name,
+			Address:   address.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
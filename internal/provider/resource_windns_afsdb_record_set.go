@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsAFSDBRecordSet() *schema.Resource {
+	r := &recordSetResource{
+		recordType: "AFSDB",
+		attribute:  "afsdb",
+		elem: map[string]*schema.Schema{
+			"subtype": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		data: func(m map[string]interface{}) fmt.Stringer {
+			return &windns.AFSDBData{
+				SubType:    m["subtype"].(int),
+				ServerName: m["server_name"].(string),
+			}
+		},
+		element: func(data interface{}) map[string]interface{} {
+			afsdb := data.(*windns.AFSDBData)
+			return map[string]interface{}{
+				"subtype":     afsdb.SubType,
+				"server_name": afsdb.ServerName,
+			}
+		},
+	}
+	return r.resource()
+}
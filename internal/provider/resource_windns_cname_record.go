@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsCNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsCNAMERecordCreate,
+		Read:   resourceDnsCNAMERecordRead,
+		Update: resourceDnsCNAMERecordUpdate,
+		Delete: resourceDnsCNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"cname": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsCNAMERecordCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+
+	rsp, err := client.AddCNAMERecord(&windns.AddCNAMERecordOptions{
+		Name:      d.Get("name").(string),
+		CName:     d.Get("cname").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+		TTL:       d.Get("ttl").(int),
+	})
+	if err != nil {
+		d.SetId("")
+		return err
+	} else if rsp.Code != http.StatusOK {
+		d.SetId("")
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsCNAMERecordRead(d, meta)
+}
+
+func resourceDnsCNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadCNAMERecord(&windns.ReadCNAMERecordOptions{
+		Name:      d.Get("name").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		d.Set("cname", rsp.Records[0].Data)
+		d.Set("ttl", rsp.Records[0].TTL)
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsCNAMERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("cname", "ttl") {
+		rsp, err := client.UpdateCNAMERecord(&windns.UpdateCNAMERecordOptions{
+			Name:      d.Get("name").(string),
+			CName:     d.Get("cname").(string),
+			ZoneName:  d.Get("zone").(string),
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       d.Get("ttl").(int),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS record: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsCNAMERecordRead(d, meta)
+}
+
+func resourceDnsCNAMERecordDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteCNAMERecord(&windns.DeleteCNAMERecordOptions{
+		Name:      d.Get("name").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsConditionalForwarder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsConditionalForwarderCreate,
+		Read:   resourceDnsConditionalForwarderRead,
+		Update: resourceDnsConditionalForwarderUpdate,
+		Delete: resourceDnsConditionalForwarderDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneImport,
+		},
+
+		CustomizeDiff: forceNewOnStorageChange,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"master_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+			},
+			"forwarder_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(0, 15),
+			},
+			"use_recursion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"replication_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Forest", "Domain", "Legacy", "Custom"}, false),
+			},
+			"directory_partition_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ad_integrated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsConditionalForwarderCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.AddConditionalForwarder(&windns.AddConditionalForwarderOptions{
+		Name:                   d.Get("name").(string),
+		MasterServers:          resourceDnsZoneMasterServers(d),
+		ForwarderTimeout:       d.Get("forwarder_timeout").(int),
+		UseRecursion:           d.Get("use_recursion").(bool),
+		ReplicationScope:       d.Get("replication_scope").(string),
+		DirectoryPartitionName: d.Get("directory_partition_name").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourceDnsConditionalForwarderRead(d, meta)
+}
+
+func resourceDnsConditionalForwarderRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZone(&windns.ReadZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Zones) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	zone := rsp.Zones[0]
+	if zone.Type != "Forwarder" {
+		return fmt.Errorf("zone %s is a %s zone, not a conditional forwarder", zone.Name, zone.Type)
+	}
+
+	if zone.IsDsIntegrated {
+		d.Set("replication_scope", zone.ReplicationScope)
+		d.Set("directory_partition_name", zone.DirectoryPartitionName)
+	} else {
+		d.Set("replication_scope", "")
+		d.Set("directory_partition_name", "")
+	}
+
+	d.Set("master_servers", zone.MasterServers)
+	d.Set("forwarder_timeout", zone.ForwarderTimeout)
+	d.Set("use_recursion", zone.UseRecursion)
+	d.Set("ad_integrated", zone.IsDsIntegrated)
+	return nil
+}
+
+func resourceDnsConditionalForwarderUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("master_servers", "forwarder_timeout", "use_recursion", "replication_scope", "directory_partition_name") {
+		rsp, err := client.UpdateConditionalForwarder(&windns.UpdateConditionalForwarderOptions{
+			Name:                   d.Id(),
+			MasterServers:          resourceDnsZoneMasterServers(d),
+			ForwarderTimeout:       d.Get("forwarder_timeout").(int),
+			UseRecursion:           d.Get("use_recursion").(bool),
+			ReplicationScope:       d.Get("replication_scope").(string),
+			DirectoryPartitionName: d.Get("directory_partition_name").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS zone: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsConditionalForwarderRead(d, meta)
+}
+
+func resourceDnsConditionalForwarderDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteZone(&windns.DeleteZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsDNAMERecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsDNAMERecordCreate,
+		Read:   resourceDnsDNAMERecordRead,
+		Update: resourceDnsDNAMERecordUpdate,
+		Delete: resourceDnsDNAMERecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"target": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsDNAMERecordCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+
+	rsp, err := client.AddRecord(&windns.AddRecordOptions{
+		Name:      d.Get("name").(string),
+		Type:      "DNAME",
+		Data:      d.Get("target").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+		TTL:       d.Get("ttl").(int),
+	})
+	if err != nil {
+		d.SetId("")
+		return err
+	} else if rsp.Code != http.StatusOK {
+		d.SetId("")
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsDNAMERecordRead(d, meta)
+}
+
+func resourceDnsDNAMERecordRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadRecord(&windns.ReadRecordOptions{
+		Name:      d.Get("name").(string),
+		Type:      "DNAME",
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		d.Set("target", rsp.Records[0].Data)
+		d.Set("ttl", rsp.Records[0].TTL)
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsDNAMERecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChange("ttl") {
+		rsp, err := client.UpdateRecord(&windns.UpdateRecordOptions{
+			Name:      d.Get("name").(string),
+			Type:      "DNAME",
+			Data:      d.Get("target").(string),
+			ZoneName:  d.Get("zone").(string),
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       d.Get("ttl").(int),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS record: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsDNAMERecordRead(d, meta)
+}
+
+func resourceDnsDNAMERecordDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteRecord(&windns.DeleteRecordOptions{
+		Name:      d.Get("name").(string),
+		Type:      "DNAME",
+		Data:      d.Get("target").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
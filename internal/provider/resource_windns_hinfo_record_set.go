@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsHINFORecordSet() *schema.Resource {
+	r := &recordSetResource{
+		recordType: "HINFO",
+		attribute:  "hinfo",
+		elem: map[string]*schema.Schema{
+			"cpu": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"os": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		data: func(m map[string]interface{}) fmt.Stringer {
+			return &windns.HINFOData{
+				CPU:             m["cpu"].(string),
+				OperatingSystem: m["os"].(string),
+			}
+		},
+		element: func(data interface{}) map[string]interface{} {
+			hinfo := data.(*windns.HINFOData)
+			return map[string]interface{}{
+				"cpu": hinfo.CPU,
+				"os":  hinfo.OperatingSystem,
+			}
+		},
+	}
+	return r.resource()
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsMXRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsMXRecordSetCreate,
+		Read:   resourceDnsMXRecordSetRead,
+		Update: resourceDnsMXRecordSetUpdate,
+		Delete: resourceDnsMXRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"mx": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"exchange": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: hashMXRecord,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func hashMXRecord(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	exchange := strings.ToLower(strings.TrimSuffix(m["exchange"].(string), "."))
+	buf.WriteString(fmt.Sprintf("%d-%s-", m["preference"].(int), exchange))
+	return hashcodeString(buf.String())
+}
+
+func resourceDnsMXRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	records := d.Get("mx").(*schema.Set).List()
+
+	for _, record := range records {
+		mx := record.(map[string]interface{})
+		rsp, err := client.AddMXRecord(&windns.AddMXRecordOptions{
+			Name:       name,
+			Preference: mx["preference"].(int),
+			Exchange:   mx["exchange"].(string),
+			ZoneName:   zone,
+			ZoneScope:  d.Get("zone_scope").(string),
+			TTL:        ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsMXRecordSetRead(d, meta)
+}
+
+func resourceDnsMXRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadMXRecord(&windns.ReadMXRecordOptions{
+		Name:      resourceRecordName(d),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		records := schema.NewSet(hashMXRecord, nil)
+		for _, record := range rsp.Records {
+			mx, ok := record.Data.(*windns.MXData)
+			if !ok {
+				return fmt.Errorf("unexpected data %v for MX record %s", record.Data, resourceFQDN(d))
+			}
+			records.Add(map[string]interface{}{
+				"preference": mx.Preference,
+				"exchange":   mx.Exchange,
+			})
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set("mx", records)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsMXRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange("mx") {
+		o, n := d.GetChange("mx")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old records and remove them
+		for _, record := range remove {
+			mx := record.(map[string]interface{})
+			rsp, err := client.DeleteMXRecord(&windns.DeleteMXRecordOptions{
+				Name:       name,
+				ZoneName:   zone,
+				ZoneScope:  d.Get("zone_scope").(string),
+				Preference: mx["preference"].(int),
+				Exchange:   mx["exchange"].(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new records and insert them
+		for _, record := range add {
+			mx := record.(map[string]interface{})
+			rsp, err := client.AddMXRecord(&windns.AddMXRecordOptions{
+				Name:       name,
+				ZoneName:   zone,
+				ZoneScope:  d.Get("zone_scope").(string),
+				Preference: mx["preference"].(int),
+				Exchange:   mx["exchange"].(string),
+				TTL:        ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsMXRecordSetRead(d, meta)
+}
+
+func resourceDnsMXRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	records := d.Get("mx").(*schema.Set).List()
+
+	for _, record := range records {
+		mx := record.(map[string]interface{})
+		rsp, err := client.DeleteMXRecord(&windns.DeleteMXRecordOptions{
+			Name:       name,
+			ZoneName:   zone,
+			ZoneScope:  d.Get("zone_scope").(string),
+			Preference: mx["preference"].(int),
+			Exchange:   mx["exchange"].(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
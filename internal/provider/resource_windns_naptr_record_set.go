@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsNAPTRRecordSet() *schema.Resource {
+	r := &recordSetResource{
+		recordType: "NAPTR",
+		attribute:  "naptr",
+		elem: map[string]*schema.Schema{
+			"order": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"preference": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+			"flags": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"services": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"regexp": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"replacement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ".",
+			},
+		},
+		data: func(m map[string]interface{}) fmt.Stringer {
+			return &windns.NAPTRData{
+				Order:       m["order"].(int),
+				Preference:  m["preference"].(int),
+				Flags:       m["flags"].(string),
+				Services:    m["services"].(string),
+				Regexp:      m["regexp"].(string),
+				Replacement: m["replacement"].(string),
+			}
+		},
+		element: func(data interface{}) map[string]interface{} {
+			naptr := data.(*windns.NAPTRData)
+			return map[string]interface{}{
+				"order":       naptr.Order,
+				"preference":  naptr.Preference,
+				"flags":       naptr.Flags,
+				"services":    naptr.Services,
+				"regexp":      naptr.Regexp,
+				"replacement": naptr.Replacement,
+			}
+		},
+	}
+	return r.resource()
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsNSRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsNSRecordSetCreate,
+		Read:   resourceDnsNSRecordSetRead,
+		Update: resourceDnsNSRecordSetUpdate,
+		Delete: resourceDnsNSRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"nameservers": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      hashFQDNString,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsNSRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	nameservers := d.Get("nameservers").(*schema.Set).List()
+
+	// the apex of a zone always holds the ns records created with the zone,
+	// adopt any that are already present instead of failing
+	for _, ns := range nameservers {
+		rsp, err := client.AddNSRecord(&windns.AddNSRecordOptions{
+			Name:       name,
+			NameServer: ns.(string),
+			ZoneName:   zone,
+			ZoneScope:  d.Get("zone_scope").(string),
+			TTL:        ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusBadRequest {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsNSRecordSetRead(d, meta)
+}
+
+func resourceDnsNSRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadNSRecord(&windns.ReadNSRecordOptions{
+		Name:      resourceRecordName(d),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		nameservers := schema.NewSet(hashFQDNString, nil)
+		for _, record := range rsp.Records {
+			nameservers.Add(record.Data)
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set("nameservers", nameservers)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsNSRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange("nameservers") {
+		o, n := d.GetChange("nameservers")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old name servers and remove them
+		for _, ns := range remove {
+			rsp, err := client.DeleteNSRecord(&windns.DeleteNSRecordOptions{
+				Name:       name,
+				ZoneName:   zone,
+				ZoneScope:  d.Get("zone_scope").(string),
+				NameServer: ns.(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new name servers and insert them
+		for _, ns := range add {
+			rsp, err := client.AddNSRecord(&windns.AddNSRecordOptions{
+				Name:       name,
+				ZoneName:   zone,
+				ZoneScope:  d.Get("zone_scope").(string),
+				NameServer: ns.(string),
+				TTL:        ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsNSRecordSetRead(d, meta)
+}
+
+func resourceDnsNSRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	nameservers := d.Get("nameservers").(*schema.Set).List()
+
+	for _, ns := range nameservers {
+		rsp, err := client.DeleteNSRecord(&windns.DeleteNSRecordOptions{
+			Name:       name,
+			NameServer: ns.(string),
+			ZoneName:   zone,
+			ZoneScope:  d.Get("zone_scope").(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsPrimaryZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsPrimaryZoneCreate,
+		Read:   resourceDnsPrimaryZoneRead,
+		Update: resourceDnsPrimaryZoneUpdate,
+		Delete: resourceDnsPrimaryZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+				ExactlyOneOf:     []string{"name", "network_id"},
+			},
+			"network_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNetworkID,
+			},
+			"zone_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"replication_scope": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"Forest", "Domain", "Legacy", "Custom"}, false),
+				ConflictsWith: []string{"zone_file", "load_existing"},
+			},
+			"directory_partition_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"replication_scope", "network_id"},
+			},
+			"load_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"dynamic_update": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"None", "Secure", "NonsecureAndSecure"}, false),
+			},
+			"ad_integrated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDnsPrimaryZoneNames returns the names of all zones managed by the
+// resource, a network that does not end on an octet or nibble boundary is
+// covered by more than one reverse lookup zone
+func resourceDnsPrimaryZoneNames(d *schema.ResourceData) []string {
+	names := []string{}
+	for _, name := range d.Get("zone_names").([]interface{}) {
+		names = append(names, name.(string))
+	}
+	if len(names) == 0 {
+		names = append(names, d.Get("name").(string))
+	}
+	return names
+}
+
+func resourceDnsPrimaryZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	zones := []*windns.ReverseZone{{Name: d.Get("name").(string)}}
+	if networkID, ok := d.GetOk("network_id"); ok {
+		var err error
+		if zones, err = windns.ReverseZones(networkID.(string)); err != nil {
+			return err
+		}
+
+		_, network, _ := net.ParseCIDR(networkID.(string))
+		d.SetId(network.String())
+		d.Set("name", zones[0].Name)
+	} else {
+		d.SetId(d.Get("name").(string))
+	}
+
+	// record each zone as it is created so a failure part way through
+	// leaves the created zones in state
+	names := []string{}
+	for _, zone := range zones {
+		rsp, err := client.AddPrimaryZone(&windns.AddPrimaryZoneOptions{
+			Name:                   zone.Name,
+			NetworkId:              zone.NetworkId,
+			ReplicationScope:       d.Get("replication_scope").(string),
+			DirectoryPartitionName: d.Get("directory_partition_name").(string),
+			ZoneFile:               d.Get("zone_file").(string),
+			LoadExisting:           d.Get("load_existing").(bool),
+			DynamicUpdate:          d.Get("dynamic_update").(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+
+		names = append(names, zone.Name)
+		d.Set("zone_names", names)
+
+		// a classless zone only resolves once the parent /24 zone
+		// delegates the addresses of the network to it
+		if zone.Parent != "" {
+			rsp, err := client.AddReverseDelegation(&windns.AddReverseDelegationOptions{
+				Zone: zone,
+			})
+			if err != nil {
+				return err
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsPrimaryZoneRead(d, meta)
+}
+
+func resourceDnsPrimaryZoneRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	names := resourceDnsPrimaryZoneNames(d)
+	zones := []*windns.Zone{}
+	for _, name := range names {
+		rsp, err := client.ReadZone(&windns.ReadZoneOptions{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code == http.StatusNotFound {
+			continue
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+
+		for _, zone := range rsp.Zones {
+			if zone.Type != "Primary" {
+				return fmt.Errorf("zone %s is a %s zone, not a primary zone", zone.Name, zone.Type)
+			}
+			zones = append(zones, zone)
+		}
+	}
+
+	if len(zones) == 0 {
+		d.SetId("")
+		return nil
+	} else if len(zones) != len(names) {
+		return fmt.Errorf("only %d of the %d reverse lookup zones of network %s exist", len(zones), len(names), d.Get("network_id"))
+	}
+
+	// the settings are applied to every zone alike, the first zone
+	// stands in for all of them. The server reports a scope of None
+	// for file backed zones and no zone file for active directory
+	// integrated zones
+	zone := zones[0]
+	if zone.IsDsIntegrated {
+		d.Set("replication_scope", zone.ReplicationScope)
+		d.Set("directory_partition_name", zone.DirectoryPartitionName)
+		d.Set("zone_file", "")
+	} else {
+		d.Set("replication_scope", "")
+		d.Set("directory_partition_name", "")
+		d.Set("zone_file", zone.ZoneFile)
+	}
+
+	d.Set("zone_names", names)
+	d.Set("dynamic_update", zone.DynamicUpdate)
+	d.Set("ad_integrated", zone.IsDsIntegrated)
+	return nil
+}
+
+func resourceDnsPrimaryZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("replication_scope", "directory_partition_name", "zone_file", "dynamic_update") {
+		for _, name := range resourceDnsPrimaryZoneNames(d) {
+			// file backed zones of a network each keep their own default
+			// zone file
+			zoneFile := d.Get("zone_file").(string)
+			if _, ok := d.GetOk("network_id"); ok {
+				zoneFile = ""
+			}
+
+			rsp, err := client.UpdatePrimaryZone(&windns.UpdatePrimaryZoneOptions{
+				Name:                   name,
+				ReplicationScope:       d.Get("replication_scope").(string),
+				DirectoryPartitionName: d.Get("directory_partition_name").(string),
+				ZoneFile:               zoneFile,
+				DynamicUpdate:          d.Get("dynamic_update").(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS zone: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsPrimaryZoneRead(d, meta)
+}
+
+func resourceDnsPrimaryZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if networkID, ok := d.GetOk("network_id"); ok {
+		zones, err := windns.ReverseZones(networkID.(string))
+		if err != nil {
+			return err
+		}
+
+		for _, zone := range zones {
+			if zone.Parent == "" {
+				continue
+			}
+			rsp, err := client.DeleteReverseDelegation(&windns.DeleteReverseDelegationOptions{
+				Zone: zone,
+			})
+			if err != nil {
+				return err
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	for _, name := range resourceDnsPrimaryZoneNames(d) {
+		rsp, err := client.DeleteZone(&windns.DeleteZoneOptions{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
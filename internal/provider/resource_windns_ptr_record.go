@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsPTRRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsPTRRecordCreate,
+		Read:   resourceDnsPTRRecordRead,
+		Update: resourceDnsPTRRecordUpdate,
+		Delete: resourceDnsPTRRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsPTRRecordImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIPAddress,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return net.ParseIP(old).Equal(net.ParseIP(new))
+				},
+			},
+			"ptr": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDnsPTRRecordImport imports a record by its ip address, records of
+// a zone scope by <ip>/<zone_scope>
+func resourceDnsPTRRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	ip := net.ParseIP(parts[0])
+	if ip == nil {
+		return nil, fmt.Errorf("Not a valid IP address: %s", parts[0])
+	}
+
+	d.Set("ip_address", ip.String())
+	if len(parts) == 2 {
+		if parts[1] == "" {
+			return nil, fmt.Errorf("Not a valid record id, expected <ip>/<zone_scope>: %s", d.Id())
+		}
+		d.Set("zone_scope", parts[1])
+	}
+	d.SetId(resourceDnsPTRRecordID(d))
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDnsPTRRecordID returns the id of the record, the scope follows the
+// ip address for records of a zone scope
+func resourceDnsPTRRecordID(d *schema.ResourceData) string {
+	id := net.ParseIP(d.Get("ip_address").(string)).String()
+	if scope, ok := d.GetOk("zone_scope"); ok {
+		id = fmt.Sprintf("%s/%s", id, scope.(string))
+	}
+	return id
+}
+
+// resourceDnsPTRRecordLocate returns the reverse zone and owner name for the
+// record, looking them up on the server when they are not yet known
+func resourceDnsPTRRecordLocate(d *schema.ResourceData, client *windns.Client) (string, string, error) {
+	zone := d.Get("zone").(string)
+	name := d.Get("name").(string)
+	if zone != "" && name != "" {
+		return zone, name, nil
+	}
+
+	zone, name, err := client.FindReverseZone(d.Get("ip_address").(string))
+	if err != nil {
+		return "", "", err
+	}
+
+	d.Set("zone", zone)
+	d.Set("name", name)
+	return zone, name, nil
+}
+
+func resourceDnsPTRRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	zone, name, err := resourceDnsPTRRecordLocate(d, client)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := client.AddPTRRecord(&windns.AddPTRRecordOptions{
+		Name:          name,
+		PtrDomainName: d.Get("ptr").(string),
+		ZoneName:      zone,
+		ZoneScope:     d.Get("zone_scope").(string),
+		TTL:           d.Get("ttl").(int),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(resourceDnsPTRRecordID(d))
+	return resourceDnsPTRRecordRead(d, meta)
+}
+
+func resourceDnsPTRRecordRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	zone, name, err := resourceDnsPTRRecordLocate(d, client)
+	if err != nil {
+		return err
+	}
+
+	rsp, err := client.ReadPTRRecord(&windns.ReadPTRRecordOptions{
+		Name:      name,
+		ZoneName:  zone,
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	// prefer the record matching the configured target when the
+	// owner name holds more than one ptr record
+	record := rsp.Records[0]
+	for _, r := range rsp.Records {
+		if suppressFQDNDiff("ptr", r.Data.(string), d.Get("ptr").(string), d) {
+			record = r
+			break
+		}
+	}
+
+	d.Set("ptr", record.Data)
+	d.Set("ttl", record.TTL)
+	return nil
+}
+
+func resourceDnsPTRRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("ptr", "ttl") {
+		o, n := d.GetChange("ptr")
+		rsp, err := client.UpdatePTRRecord(&windns.UpdatePTRRecordOptions{
+			Name:             d.Get("name").(string),
+			ZoneName:         d.Get("zone").(string),
+			ZoneScope:        d.Get("zone_scope").(string),
+			PtrDomainName:    o.(string),
+			NewPtrDomainName: n.(string),
+			TTL:              d.Get("ttl").(int),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS record: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsPTRRecordRead(d, meta)
+}
+
+func resourceDnsPTRRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeletePTRRecord(&windns.DeletePTRRecordOptions{
+		Name:          d.Get("name").(string),
+		ZoneName:      d.Get("zone").(string),
+		ZoneScope:     d.Get("zone_scope").(string),
+		PtrDomainName: d.Get("ptr").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
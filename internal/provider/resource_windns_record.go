@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsRecordCreate,
+		Read:   resourceDnsRecordRead,
+		Update: resourceDnsRecordUpdate,
+		Delete: resourceDnsRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsRecordImport,
+		},
+
+		CustomizeDiff: resourceDnsRecordCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagedRecordType,
+				StateFunc: func(v interface{}) string {
+					return strings.ToUpper(v.(string))
+				},
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressRecordDataDiff,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+// resourceDnsRecordImport imports a record by the id it is created with,
+// <fqdn>[/<zone_scope>]/<type>/<data>
+func resourceDnsRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if meta == nil {
+		return nil, fmt.Errorf("client not created")
+	}
+
+	id, recordType, data, err := parseDnsRecordID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn, scope, err := parseRecordID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resourceDnsImportFQDN(d, meta.(*windns.Client), fqdn, scope); err != nil {
+		return nil, err
+	}
+
+	d.Set("type", recordType)
+	d.Set("data", data)
+	d.SetId(fmt.Sprintf("%s/%s/%s", resourceRecordID(d), recordType, data))
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseDnsRecordID splits the id of a record into the record id built by
+// resourceRecordID, the record type and the canonical data. A zone scope is
+// present when the segment after it names a record type, record data never
+// starts with a record type followed by a slash
+func parseDnsRecordID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 4)
+	if len(parts) == 4 && windns.ValidateManagedRecordType(parts[2]) == nil {
+		parts = []string{parts[0] + "/" + parts[1], parts[2], parts[3]}
+	} else {
+		parts = strings.SplitN(id, "/", 3)
+	}
+
+	if len(parts) != 3 || windns.ValidateManagedRecordType(parts[1]) != nil {
+		return "", "", "", fmt.Errorf("Not a valid record id, expected <fqdn>[/<zone_scope>]/<type>/<data>: %s", id)
+	}
+
+	recordType := strings.ToUpper(parts[1])
+	data, err := windns.NormalizeRecordData(recordType, parts[2])
+	if err != nil {
+		return "", "", "", err
+	}
+	return parts[0], recordType, data, nil
+}
+
+// resourceDnsRecordCustomizeDiff rejects data that does not parse for the
+// record type at plan time
+func resourceDnsRecordCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("data") {
+		return nil
+	}
+	_, err := windns.NormalizeRecordData(d.Get("type").(string), d.Get("data").(string))
+	return err
+}
+
+// suppressRecordDataDiff compares record data in its canonical form
+func suppressRecordDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	recordType := d.Get("type").(string)
+	o, err := windns.NormalizeRecordData(recordType, old)
+	if err != nil {
+		return false
+	}
+	n, err := windns.NormalizeRecordData(recordType, new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
+
+func resourceDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	recordType := strings.ToUpper(d.Get("type").(string))
+	data := d.Get("data").(string)
+
+	rsp, err := client.AddRecord(&windns.AddRecordOptions{
+		Name:      resourceRecordName(d),
+		Type:      recordType,
+		Data:      data,
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+		TTL:       d.Get("ttl").(int),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	normalized, err := windns.NormalizeRecordData(recordType, data)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", resourceRecordID(d), recordType, normalized))
+	return resourceDnsRecordRead(d, meta)
+}
+
+func resourceDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	recordType := d.Get("type").(string)
+	rsp, err := client.ReadRecord(&windns.ReadRecordOptions{
+		Name:      resourceRecordName(d),
+		Type:      recordType,
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	want, err := windns.NormalizeRecordData(recordType, d.Get("data").(string))
+	if err != nil {
+		return err
+	}
+
+	for _, record := range rsp.Records {
+		data, ok := record.Data.(string)
+		if !ok {
+			continue
+		}
+		if normalized, err := windns.NormalizeRecordData(recordType, data); err == nil && normalized == want {
+			d.Set("data", data)
+			d.Set("ttl", record.TTL)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChange("ttl") {
+		rsp, err := client.UpdateRecord(&windns.UpdateRecordOptions{
+			Name:      resourceRecordName(d),
+			Type:      d.Get("type").(string),
+			Data:      d.Get("data").(string),
+			ZoneName:  d.Get("zone").(string),
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       d.Get("ttl").(int),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS record: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsRecordRead(d, meta)
+}
+
+func resourceDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteRecord(&windns.DeleteRecordOptions{
+		Name:      resourceRecordName(d),
+		Type:      d.Get("type").(string),
+		Data:      d.Get("data").(string),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// recordSetResource describes a record set resource whose set elements
+// map onto the typed data of a record type and are managed through the
+// generic record client methods
+type recordSetResource struct {
+	recordType string
+	attribute  string
+	elem       map[string]*schema.Schema
+	// data renders a set element as typed record data
+	data func(m map[string]interface{}) fmt.Stringer
+	// element converts typed record data into a set element
+	element func(data interface{}) map[string]interface{}
+}
+
+func (r *recordSetResource) resource() *schema.Resource {
+	return &schema.Resource{
+		Create: r.create,
+		Read:   r.read,
+		Update: r.update,
+		Delete: r.delete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			r.attribute: {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Resource{Schema: r.elem},
+				Set:      r.hash,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+// hash hashes the canonical record data of a set element
+func (r *recordSetResource) hash(v interface{}) int {
+	data := r.data(v.(map[string]interface{})).String()
+	if normalized, err := windns.NormalizeRecordData(r.recordType, data); err == nil {
+		data = normalized
+	}
+	return hashcodeString(data)
+}
+
+func (r *recordSetResource) create(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	elements := d.Get(r.attribute).(*schema.Set).List()
+
+	for _, element := range elements {
+		rsp, err := client.AddRecord(&windns.AddRecordOptions{
+			Name:      name,
+			Type:      r.recordType,
+			Data:      r.data(element.(map[string]interface{})).String(),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return r.read(d, meta)
+}
+
+func (r *recordSetResource) read(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadRecord(&windns.ReadRecordOptions{
+		Name:      resourceRecordName(d),
+		Type:      r.recordType,
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		elements := schema.NewSet(r.hash, nil)
+		for _, record := range rsp.Records {
+			data, err := windns.ParseRecordData(r.recordType, record.Data.(string))
+			if err != nil {
+				return err
+			}
+			elements.Add(r.element(data))
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set(r.attribute, elements)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func (r *recordSetResource) update(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange(r.attribute) {
+		o, n := d.GetChange(r.attribute)
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old records and remove them
+		for _, element := range remove {
+			rsp, err := client.DeleteRecord(&windns.DeleteRecordOptions{
+				Name:      name,
+				Type:      r.recordType,
+				Data:      r.data(element.(map[string]interface{})).String(),
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new records and insert them
+		for _, element := range add {
+			rsp, err := client.AddRecord(&windns.AddRecordOptions{
+				Name:      name,
+				Type:      r.recordType,
+				Data:      r.data(element.(map[string]interface{})).String(),
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				TTL:       ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return r.read(d, meta)
+}
+
+func (r *recordSetResource) delete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	elements := d.Get(r.attribute).(*schema.Set).List()
+
+	for _, element := range elements {
+		rsp, err := client.DeleteRecord(&windns.DeleteRecordOptions{
+			Name:      name,
+			Type:      r.recordType,
+			Data:      r.data(element.(map[string]interface{})).String(),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsRPRecordSet() *schema.Resource {
+	r := &recordSetResource{
+		recordType: "RP",
+		attribute:  "rp",
+		elem: map[string]*schema.Schema{
+			"mailbox": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"txt_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ".",
+			},
+		},
+		data: func(m map[string]interface{}) fmt.Stringer {
+			return &windns.RPData{
+				Mailbox:   m["mailbox"].(string),
+				TXTDomain: m["txt_domain"].(string),
+			}
+		},
+		element: func(data interface{}) map[string]interface{} {
+			rp := data.(*windns.RPData)
+			return map[string]interface{}{
+				"mailbox":    rp.Mailbox,
+				"txt_domain": rp.TXTDomain,
+			}
+		},
+	}
+	return r.resource()
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsSecondaryZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsSecondaryZoneCreate,
+		Read:   resourceDnsSecondaryZoneRead,
+		Update: resourceDnsSecondaryZoneUpdate,
+		Delete: resourceDnsSecondaryZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"master_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+			},
+			"zone_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"load_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"last_transfer_attempt": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_transfer_result": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_successful_transfer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_successful_soa_check": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsSecondaryZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.AddSecondaryZone(&windns.AddSecondaryZoneOptions{
+		Name:          d.Get("name").(string),
+		MasterServers: resourceDnsZoneMasterServers(d),
+		ZoneFile:      d.Get("zone_file").(string),
+		LoadExisting:  d.Get("load_existing").(bool),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourceDnsSecondaryZoneRead(d, meta)
+}
+
+func resourceDnsSecondaryZoneRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZone(&windns.ReadZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Zones) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	zone := rsp.Zones[0]
+	if zone.Type != "Secondary" {
+		return fmt.Errorf("zone %s is a %s zone, not a secondary zone", zone.Name, zone.Type)
+	}
+
+	d.Set("master_servers", zone.MasterServers)
+	d.Set("zone_file", zone.ZoneFile)
+	d.Set("last_transfer_attempt", zone.LastTransferAttempt)
+	d.Set("last_transfer_result", zone.LastTransferResult)
+	d.Set("last_successful_transfer", zone.LastSuccessfulTransfer)
+	d.Set("last_successful_soa_check", zone.LastSuccessfulSOACheck)
+	return nil
+}
+
+func resourceDnsSecondaryZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("master_servers", "zone_file") {
+		rsp, err := client.UpdateSecondaryZone(&windns.UpdateSecondaryZoneOptions{
+			Name:          d.Id(),
+			MasterServers: resourceDnsZoneMasterServers(d),
+			ZoneFile:      d.Get("zone_file").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS zone: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsSecondaryZoneRead(d, meta)
+}
+
+func resourceDnsSecondaryZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteZone(&windns.DeleteZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
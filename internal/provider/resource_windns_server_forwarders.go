@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceDnsServerForwarders manages the forwarders of the server the
+// provider is configured for, there is one instance per dns_server
+func resourceDnsServerForwarders() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsServerForwardersCreate,
+		Read:   resourceDnsServerForwardersRead,
+		Update: resourceDnsServerForwardersUpdate,
+		Delete: resourceDnsServerForwardersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateFunc:     validateIPAddress,
+					DiffSuppressFunc: suppressIPDiff,
+				},
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 15),
+			},
+			"use_root_hint": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dns_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsServerForwardersCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateForwarders(&windns.UpdateForwardersOptions{
+		IPAddresses: resourceDnsServerForwardersAddresses(d),
+		Timeout:     d.Get("timeout").(int),
+		UseRootHint: d.Get("use_root_hint").(bool),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	} else if rsp.Forwarders == nil {
+		return fmt.Errorf("no forwarders returned")
+	}
+
+	d.SetId(rsp.Forwarders.DnsServer)
+	return resourceDnsServerForwardersRead(d, meta)
+}
+
+func resourceDnsServerForwardersRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadForwarders(&windns.ReadForwardersOptions{})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if rsp.Forwarders == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// the id names the server, an import of another server's forwarders
+	// would silently manage the wrong one
+	if !strings.EqualFold(d.Id(), rsp.Forwarders.DnsServer) {
+		return fmt.Errorf("forwarders %q do not belong to the configured dns_server %q", d.Id(), rsp.Forwarders.DnsServer)
+	}
+
+	// a server without forwarders resolves through the root hints only
+	if len(rsp.Forwarders.IPAddresses) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("ip_addresses", rsp.Forwarders.IPAddresses)
+	d.Set("timeout", rsp.Forwarders.Timeout)
+	d.Set("use_root_hint", rsp.Forwarders.UseRootHint)
+	d.Set("dns_server", rsp.Forwarders.DnsServer)
+	return nil
+}
+
+func resourceDnsServerForwardersUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateForwarders(&windns.UpdateForwardersOptions{
+		IPAddresses: resourceDnsServerForwardersAddresses(d),
+		Timeout:     d.Get("timeout").(int),
+		UseRootHint: d.Get("use_root_hint").(bool),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating DNS server forwarders: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsServerForwardersRead(d, meta)
+}
+
+// resourceDnsServerForwardersDelete removes all forwarders and restores the
+// default timeout and root hint fallback
+func resourceDnsServerForwardersDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteForwarders(&windns.DeleteForwardersOptions{})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
+
+// resourceDnsServerForwardersAddresses returns the configured forwarders in
+// the order they are queried
+func resourceDnsServerForwardersAddresses(d *schema.ResourceData) []string {
+	addresses := []string{}
+	for _, addr := range d.Get("ip_addresses").([]interface{}) {
+		addresses = append(addresses, addr.(string))
+	}
+	return addresses
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsSRVRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsSRVRecordSetCreate,
+		Read:   resourceDnsSRVRecordSetRead,
+		Update: resourceDnsSRVRecordSetUpdate,
+		Delete: resourceDnsSRVRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"srv": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"target": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+				Set: hashSRVRecord,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func hashSRVRecord(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	target := strings.ToLower(strings.TrimSuffix(m["target"].(string), "."))
+	buf.WriteString(fmt.Sprintf("%d-%d-%d-%s-", m["priority"].(int), m["weight"].(int), m["port"].(int), target))
+	return hashcodeString(buf.String())
+}
+
+func resourceDnsSRVRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	records := d.Get("srv").(*schema.Set).List()
+
+	for _, record := range records {
+		srv := record.(map[string]interface{})
+		rsp, err := client.AddSRVRecord(&windns.AddSRVRecordOptions{
+			Name:      name,
+			Priority:  srv["priority"].(int),
+			Weight:    srv["weight"].(int),
+			Port:      srv["port"].(int),
+			Target:    srv["target"].(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsSRVRecordSetRead(d, meta)
+}
+
+func resourceDnsSRVRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadSRVRecord(&windns.ReadSRVRecordOptions{
+		Name:      resourceRecordName(d),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		records := schema.NewSet(hashSRVRecord, nil)
+		for _, record := range rsp.Records {
+			srv, ok := record.Data.(*windns.SRVData)
+			if !ok {
+				return fmt.Errorf("unexpected data %v for SRV record %s", record.Data, resourceFQDN(d))
+			}
+			records.Add(map[string]interface{}{
+				"priority": srv.Priority,
+				"weight":   srv.Weight,
+				"port":     srv.Port,
+				"target":   srv.Target,
+			})
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set("srv", records)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsSRVRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange("srv") {
+		o, n := d.GetChange("srv")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old records and remove them
+		for _, record := range remove {
+			srv := record.(map[string]interface{})
+			rsp, err := client.DeleteSRVRecord(&windns.DeleteSRVRecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Priority:  srv["priority"].(int),
+				Weight:    srv["weight"].(int),
+				Port:      srv["port"].(int),
+				Target:    srv["target"].(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new records and insert them
+		for _, record := range add {
+			srv := record.(map[string]interface{})
+			rsp, err := client.AddSRVRecord(&windns.AddSRVRecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Priority:  srv["priority"].(int),
+				Weight:    srv["weight"].(int),
+				Port:      srv["port"].(int),
+				Target:    srv["target"].(string),
+				TTL:       ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsSRVRecordSetRead(d, meta)
+}
+
+func resourceDnsSRVRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	records := d.Get("srv").(*schema.Set).List()
+
+	for _, record := range records {
+		srv := record.(map[string]interface{})
+		rsp, err := client.DeleteSRVRecord(&windns.DeleteSRVRecordOptions{
+			Name:      name,
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			Priority:  srv["priority"].(int),
+			Weight:    srv["weight"].(int),
+			Port:      srv["port"].(int),
+			Target:    srv["target"].(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsStubZone() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsStubZoneCreate,
+		Read:   resourceDnsStubZoneRead,
+		Update: resourceDnsStubZoneUpdate,
+		Delete: resourceDnsStubZoneDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneImport,
+		},
+
+		CustomizeDiff: forceNewOnStorageChange,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"master_servers": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+			},
+			"replication_scope": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"Forest", "Domain", "Legacy", "Custom"}, false),
+				ConflictsWith: []string{"zone_file"},
+			},
+			"directory_partition_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"replication_scope"},
+			},
+			"load_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"ad_integrated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsStubZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.AddStubZone(&windns.AddStubZoneOptions{
+		Name:                   d.Get("name").(string),
+		MasterServers:          resourceDnsZoneMasterServers(d),
+		ReplicationScope:       d.Get("replication_scope").(string),
+		DirectoryPartitionName: d.Get("directory_partition_name").(string),
+		ZoneFile:               d.Get("zone_file").(string),
+		LoadExisting:           d.Get("load_existing").(bool),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(d.Get("name").(string))
+	return resourceDnsStubZoneRead(d, meta)
+}
+
+func resourceDnsStubZoneRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZone(&windns.ReadZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Zones) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	zone := rsp.Zones[0]
+	if zone.Type != "Stub" {
+		return fmt.Errorf("zone %s is a %s zone, not a stub zone", zone.Name, zone.Type)
+	}
+
+	if zone.IsDsIntegrated {
+		d.Set("replication_scope", zone.ReplicationScope)
+		d.Set("directory_partition_name", zone.DirectoryPartitionName)
+		d.Set("zone_file", "")
+	} else {
+		d.Set("replication_scope", "")
+		d.Set("directory_partition_name", "")
+		d.Set("zone_file", zone.ZoneFile)
+	}
+
+	d.Set("master_servers", zone.MasterServers)
+	d.Set("ad_integrated", zone.IsDsIntegrated)
+	return nil
+}
+
+func resourceDnsStubZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("master_servers", "replication_scope", "directory_partition_name") {
+		rsp, err := client.UpdateStubZone(&windns.UpdateStubZoneOptions{
+			Name:                   d.Id(),
+			MasterServers:          resourceDnsZoneMasterServers(d),
+			ReplicationScope:       d.Get("replication_scope").(string),
+			DirectoryPartitionName: d.Get("directory_partition_name").(string),
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating DNS zone: %s", err)
+		} else if rsp.Code != http.StatusOK {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsStubZoneRead(d, meta)
+}
+
+func resourceDnsStubZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteZone(&windns.DeleteZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
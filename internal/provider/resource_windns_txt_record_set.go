@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsTXTRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsTXTRecordSetCreate,
+		Read:   resourceDnsTXTRecordSetRead,
+		Update: resourceDnsTXTRecordSetUpdate,
+		Delete: resourceDnsTXTRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateZone,
+			},
+			"zone_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"txt": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  3600,
+			},
+		},
+	}
+}
+
+func resourceDnsTXTRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(resourceRecordID(d))
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+	values := d.Get("txt").(*schema.Set).List()
+
+	for _, text := range values {
+		rsp, err := client.AddTXTRecord(&windns.AddTXTRecordOptions{
+			Name:      name,
+			Text:      text.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+			TTL:       ttl,
+		})
+		if err != nil {
+			d.SetId("")
+			return err
+		} else if rsp.Code != http.StatusOK {
+			d.SetId("")
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return resourceDnsTXTRecordSetRead(d, meta)
+}
+
+func resourceDnsTXTRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadTXTRecord(&windns.ReadTXTRecordOptions{
+		Name:      resourceRecordName(d),
+		ZoneName:  d.Get("zone").(string),
+		ZoneScope: d.Get("zone_scope").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Records) > 0 {
+		var ttl sort.IntSlice
+		values := schema.NewSet(schema.HashString, nil)
+		for _, record := range rsp.Records {
+			data, ok := record.Data.(*windns.TXTData)
+			if !ok {
+				return fmt.Errorf("unexpected data %v for TXT record %s", record.Data, resourceFQDN(d))
+			}
+			values.Add(data.Text())
+			ttl = append(ttl, record.TTL)
+		}
+		sort.Sort(ttl)
+
+		d.Set("txt", values)
+		d.Set("ttl", ttl[0])
+	} else {
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceDnsTXTRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	ttl := d.Get("ttl").(int)
+
+	if d.HasChange("txt") {
+		o, n := d.GetChange("txt")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+		remove := os.Difference(ns).List()
+		add := ns.Difference(os).List()
+
+		// Loop through all the old values and remove them
+		for _, text := range remove {
+			rsp, err := client.DeleteTXTRecord(&windns.DeleteTXTRecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Text:      text.(string),
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+		// Loop through all the new values and insert them
+		for _, text := range add {
+			rsp, err := client.AddTXTRecord(&windns.AddTXTRecordOptions{
+				Name:      name,
+				ZoneName:  zone,
+				ZoneScope: d.Get("zone_scope").(string),
+				Text:      text.(string),
+				TTL:       ttl,
+			})
+			if err != nil {
+				return fmt.Errorf("Error updating DNS record: %s", err)
+			} else if rsp.Code != http.StatusOK {
+				return fmt.Errorf(rsp.Detail)
+			}
+		}
+	}
+
+	return resourceDnsTXTRecordSetRead(d, meta)
+}
+
+func resourceDnsTXTRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	name := resourceRecordName(d)
+	zone := d.Get("zone").(string)
+	values := d.Get("txt").(*schema.Set).List()
+
+	for _, text := range values {
+		rsp, err := client.DeleteTXTRecord(&windns.DeleteTXTRecordOptions{
+			Name:      name,
+			Text:      text.(string),
+			ZoneName:  zone,
+			ZoneScope: d.Get("zone_scope").(string),
+		})
+		if err != nil {
+			return err
+		} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+			return fmt.Errorf(rsp.Detail)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsZoneAging() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneAgingCreate,
+		Read:   resourceDnsZoneAgingRead,
+		Update: resourceDnsZoneAgingUpdate,
+		Delete: resourceDnsZoneAgingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"aging_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"no_refresh_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      168,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"refresh_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      168,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"scavenge_servers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+				Set: hashIPString,
+			},
+			// the time after which the records of the zone may be scavenged,
+			// not when the next scavenging run is scheduled
+			"available_for_scavenge_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// the next scavenging run of the server, empty while scavenging
+			// is disabled or before it first ran
+			"next_scavenge_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsZoneAgingCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	d.SetId(d.Get("zone").(string))
+	return resourceDnsZoneAgingUpdate(d, meta)
+}
+
+func resourceDnsZoneAgingRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZoneAging(&windns.ReadZoneAgingOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if rsp.Aging == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("aging_enabled", rsp.Aging.AgingEnabled)
+	d.Set("no_refresh_interval", rsp.Aging.NoRefreshInterval)
+	d.Set("refresh_interval", rsp.Aging.RefreshInterval)
+	d.Set("scavenge_servers", rsp.Aging.ScavengeServers)
+	d.Set("available_for_scavenge_time", rsp.Aging.AvailForScavengeTime)
+	d.Set("next_scavenge_time", rsp.Aging.NextScavengeTime)
+	return nil
+}
+
+func resourceDnsZoneAgingUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateZoneAging(&windns.UpdateZoneAgingOptions{
+		Name:              d.Id(),
+		AgingEnabled:      d.Get("aging_enabled").(bool),
+		NoRefreshInterval: d.Get("no_refresh_interval").(int),
+		RefreshInterval:   d.Get("refresh_interval").(int),
+		ScavengeServers:   setToStrings(d.Get("scavenge_servers").(*schema.Set)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating DNS zone: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsZoneAgingRead(d, meta)
+}
+
+// resourceDnsZoneAgingDelete turns aging off and leaves the intervals and
+// scavenge servers as they are
+func resourceDnsZoneAgingDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateZoneAging(&windns.UpdateZoneAgingOptions{
+		Name:              d.Id(),
+		AgingEnabled:      false,
+		NoRefreshInterval: d.Get("no_refresh_interval").(int),
+		RefreshInterval:   d.Get("refresh_interval").(int),
+		ScavengeServers:   setToStrings(d.Get("scavenge_servers").(*schema.Set)),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsZoneDelegation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneDelegationCreate,
+		Read:   resourceDnsZoneDelegationRead,
+		Update: resourceDnsZoneDelegationUpdate,
+		Delete: resourceDnsZoneDelegationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneDelegationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"child_zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateName,
+			},
+			"name_server": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateZone,
+							DiffSuppressFunc: suppressFQDNDiff,
+						},
+						"ip_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateIPAddress,
+							},
+							Set: hashIPString,
+						},
+					},
+				},
+				Set: hashDelegationNameServer,
+			},
+		},
+	}
+}
+
+// hashDelegationNameServer hashes a name server ignoring case and the
+// trailing dot of its name and the spelling of its glue addresses
+func hashDelegationNameServer(v interface{}) int {
+	m := v.(map[string]interface{})
+	addrs := []string{}
+	switch v := m["ip_addresses"].(type) {
+	case *schema.Set:
+		addrs = setToStrings(v)
+	case []interface{}:
+		for _, addr := range v {
+			addrs = append(addrs, addr.(string))
+		}
+	case []string:
+		addrs = append(addrs, v...)
+	}
+	for i, addr := range addrs {
+		if ip := net.ParseIP(addr); ip != nil {
+			addrs[i] = ip.String()
+		}
+	}
+	sort.Strings(addrs)
+
+	name := strings.ToLower(strings.TrimSuffix(m["name"].(string), "."))
+	return hashcodeString(name + "/" + strings.Join(addrs, ","))
+}
+
+// resourceDnsZoneDelegationNameServers returns the configured name servers
+// of a delegation with their glue addresses
+func resourceDnsZoneDelegationNameServers(d *schema.ResourceData) []*windns.DelegationNameServer {
+	nameServers := []*windns.DelegationNameServer{}
+	for _, element := range d.Get("name_server").(*schema.Set).List() {
+		m := element.(map[string]interface{})
+		nameServers = append(nameServers, &windns.DelegationNameServer{
+			Name:        m["name"].(string),
+			IPAddresses: setToStrings(m["ip_addresses"].(*schema.Set)),
+		})
+	}
+	return nameServers
+}
+
+func resourceDnsZoneDelegationCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.AddZoneDelegation(&windns.AddZoneDelegationOptions{
+		ZoneName:      d.Get("zone").(string),
+		ChildZoneName: d.Get("child_zone_name").(string),
+		NameServers:   resourceDnsZoneDelegationNameServers(d),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("zone").(string), d.Get("child_zone_name").(string)))
+	return resourceDnsZoneDelegationRead(d, meta)
+}
+
+func resourceDnsZoneDelegationRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZoneDelegation(&windns.ReadZoneDelegationOptions{
+		ZoneName:      d.Get("zone").(string),
+		ChildZoneName: d.Get("child_zone_name").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if rsp.Delegation == nil || len(rsp.Delegation.NameServers) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	nameServers := []interface{}{}
+	for _, nameServer := range rsp.Delegation.NameServers {
+		nameServers = append(nameServers, map[string]interface{}{
+			"name":         nameServer.Name,
+			"ip_addresses": nameServer.IPAddresses,
+		})
+	}
+
+	if err := d.Set("name_server", nameServers); err != nil {
+		return fmt.Errorf("Error setting name servers: %s", err)
+	}
+	return nil
+}
+
+func resourceDnsZoneDelegationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateZoneDelegation(&windns.UpdateZoneDelegationOptions{
+		ZoneName:      d.Get("zone").(string),
+		ChildZoneName: d.Get("child_zone_name").(string),
+		NameServers:   resourceDnsZoneDelegationNameServers(d),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating DNS zone delegation: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsZoneDelegationRead(d, meta)
+}
+
+func resourceDnsZoneDelegationDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteZoneDelegation(&windns.DeleteZoneDelegationOptions{
+		ZoneName:      d.Get("zone").(string),
+		ChildZoneName: d.Get("child_zone_name").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
+
+// resourceDnsZoneDelegationImport imports a zone delegation by an id of the
+// form <zone>/<child zone name>
+func resourceDnsZoneDelegationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("invalid zone delegation id %q, expected <zone>/<child zone name>", d.Id())
+	}
+
+	zone, child := d.Id()[:i], d.Id()[i+1:]
+	if !strings.HasSuffix(zone, ".") {
+		zone += "."
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zone, child))
+	d.Set("zone", zone)
+	d.Set("child_zone_name", child)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDnsZoneScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneScopeCreate,
+		Read:   resourceDnsZoneScopeRead,
+		Delete: resourceDnsZoneScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneScopeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"load_existing": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"file_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDnsZoneScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.AddZoneScope(&windns.AddZoneScopeOptions{
+		ZoneName:     d.Get("zone").(string),
+		Name:         d.Get("name").(string),
+		LoadExisting: d.Get("load_existing").(bool),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("zone").(string), d.Get("name").(string)))
+	return resourceDnsZoneScopeRead(d, meta)
+}
+
+func resourceDnsZoneScopeRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZoneScope(&windns.ReadZoneScopeOptions{
+		ZoneName: d.Get("zone").(string),
+		Name:     d.Get("name").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.ZoneScopes) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("file_name", rsp.ZoneScopes[0].FileName)
+	return nil
+}
+
+func resourceDnsZoneScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.DeleteZoneScope(&windns.DeleteZoneScopeOptions{
+		ZoneName: d.Get("zone").(string),
+		Name:     d.Get("name").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
+
+// resourceDnsZoneScopeImport imports a zone scope by an id of the form
+// <zone>/<scope>
+func resourceDnsZoneScopeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("invalid zone scope id %q, expected <zone>/<scope>", d.Id())
+	}
+
+	zone, name := d.Id()[:i], d.Id()[i+1:]
+	if !strings.HasSuffix(zone, ".") {
+		zone += "."
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", zone, name))
+	d.Set("zone", zone)
+	d.Set("name", name)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultKeyStorageProvider = "Microsoft Software Key Storage Provider"
+
+func resourceDnsZoneSigning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneSigningCreate,
+		Read:   resourceDnsZoneSigningRead,
+		Update: resourceDnsZoneSigningUpdate,
+		Delete: resourceDnsZoneSigningDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"key": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"KeySigningKey", "ZoneSigningKey"}, false),
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"RsaSha1", "RsaSha1NSec3", "RsaSha256", "RsaSha512", "ECDsaP256Sha256", "ECDsaP384Sha384",
+							}, false),
+						},
+						// key_length and rollover_period_days are not computed, a
+						// list copies computed values by position when keys are
+						// reordered. Unset they stay 0 and the server default applies
+						"key_length": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"key_storage_provider": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  defaultKeyStorageProvider,
+						},
+						"rollover_period_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"key_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_rollover_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_rollover_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_rollover_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"denial_of_existence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NSec3",
+				ValidateFunc: validation.StringInSlice([]string{"NSec", "NSec3"}, false),
+			},
+			"nsec3_iterations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntBetween(0, 2500),
+			},
+			"nsec3_opt_out": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"nsec3_random_salt_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      8,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"enable_rfc5011_key_rollover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// resourceDnsZoneSigningKeyMatches reports whether the configured key n is
+// the existing key o. Keys are matched on the settings that can only be
+// changed by replacing the key, never on their position in the list
+func resourceDnsZoneSigningKeyMatches(o, n map[string]interface{}) bool {
+	if o["key_type"] != n["key_type"] || o["algorithm"] != n["algorithm"] || o["key_storage_provider"] != n["key_storage_provider"] {
+		return false
+	}
+	return n["key_length"].(int) == 0 || o["key_length"] == n["key_length"]
+}
+
+// resourceDnsZoneSigningAddKey adds a configured key to the zone and
+// returns the id the server assigned to it
+func resourceDnsZoneSigningAddKey(d *schema.ResourceData, client *windns.Client, key map[string]interface{}) (string, error) {
+	rsp, err := client.AddSigningKey(&windns.AddSigningKeyOptions{
+		ZoneName:           d.Id(),
+		KeyType:            key["key_type"].(string),
+		CryptoAlgorithm:    key["algorithm"].(string),
+		KeyLength:          key["key_length"].(int),
+		KeyStorageProvider: key["key_storage_provider"].(string),
+		RolloverPeriod:     key["rollover_period_days"].(int),
+	})
+	if err != nil {
+		return "", err
+	} else if rsp.Code != http.StatusOK {
+		return "", fmt.Errorf(rsp.Detail)
+	} else if len(rsp.SigningKeys) == 0 {
+		return "", fmt.Errorf("no signing key returned for zone %s", d.Id())
+	}
+	return rsp.SigningKeys[0].KeyId, nil
+}
+
+func resourceDnsZoneSigningDeleteKey(d *schema.ResourceData, client *windns.Client, key map[string]interface{}) error {
+	rsp, err := client.DeleteSigningKey(&windns.DeleteSigningKeyOptions{
+		ZoneName: d.Id(),
+		KeyId:    key["key_id"].(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+	return nil
+}
+
+func resourceDnsZoneSigningUpdateSettings(d *schema.ResourceData, client *windns.Client) error {
+	rsp, err := client.UpdateZoneDnsSec(&windns.UpdateZoneDnsSecOptions{
+		Name:                     d.Id(),
+		DenialOfExistence:        d.Get("denial_of_existence").(string),
+		NSec3Iterations:          d.Get("nsec3_iterations").(int),
+		NSec3OptOut:              d.Get("nsec3_opt_out").(bool),
+		NSec3RandomSaltLength:    d.Get("nsec3_random_salt_length").(int),
+		EnableRfc5011KeyRollover: d.Get("enable_rfc5011_key_rollover").(bool),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+	return nil
+}
+
+func resourceDnsZoneSigningSign(d *schema.ResourceData, client *windns.Client) error {
+	rsp, err := client.SignZone(&windns.SignZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+	return nil
+}
+
+func resourceDnsZoneSigningCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	d.SetId(d.Get("zone").(string))
+
+	if err := resourceDnsZoneSigningUpdateSettings(d, client); err != nil {
+		return err
+	}
+
+	// the key ids let Read keep the keys in the configured order
+	keys := []interface{}{}
+	for _, element := range d.Get("key").([]interface{}) {
+		key := element.(map[string]interface{})
+		id, err := resourceDnsZoneSigningAddKey(d, client, key)
+		if err != nil {
+			return err
+		}
+		key["key_id"] = id
+		keys = append(keys, key)
+	}
+	d.Set("key", keys)
+
+	if err := resourceDnsZoneSigningSign(d, client); err != nil {
+		return err
+	}
+
+	return resourceDnsZoneSigningRead(d, meta)
+}
+
+func resourceDnsZoneSigningRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZoneSigning(&windns.ReadZoneSigningOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if rsp.DnsSec == nil || !rsp.DnsSec.IsSigned {
+		d.SetId("")
+		return nil
+	}
+
+	// keep the keys in the configured order, keys removed on the server
+	// drop out and keys added outside of terraform are appended
+	serverKeys := map[string]*windns.SigningKey{}
+	for _, key := range rsp.SigningKeys {
+		serverKeys[key.KeyId] = key
+	}
+
+	ordered := []*windns.SigningKey{}
+	prior := map[string]map[string]interface{}{}
+	for _, element := range d.Get("key").([]interface{}) {
+		key := element.(map[string]interface{})
+		id := key["key_id"].(string)
+		prior[id] = key
+		if serverKey, ok := serverKeys[id]; ok {
+			ordered = append(ordered, serverKey)
+			delete(serverKeys, id)
+		}
+	}
+	for _, key := range rsp.SigningKeys {
+		if _, ok := serverKeys[key.KeyId]; ok {
+			ordered = append(ordered, key)
+		}
+	}
+
+	keys := []map[string]interface{}{}
+	for _, key := range ordered {
+		// settings left to the server default stay unset
+		length, period := key.KeyLength, key.RolloverPeriod
+		if p, ok := prior[key.KeyId]; ok {
+			if p["key_length"].(int) == 0 {
+				length = 0
+			}
+			if p["rollover_period_days"].(int) == 0 {
+				period = 0
+			}
+		}
+
+		keys = append(keys, map[string]interface{}{
+			"key_type":                key.KeyType,
+			"algorithm":               key.CryptoAlgorithm,
+			"key_length":              length,
+			"key_storage_provider":    key.KeyStorageProvider,
+			"rollover_period_days":    period,
+			"key_id":                  key.KeyId,
+			"current_state":           key.CurrentState,
+			"current_rollover_status": key.CurrentRolloverStatus,
+			"last_rollover_time":      key.LastRolloverTime,
+			"next_rollover_time":      key.NextRolloverTime,
+		})
+	}
+
+	d.Set("key", keys)
+	d.Set("denial_of_existence", rsp.DnsSec.DenialOfExistence)
+	d.Set("enable_rfc5011_key_rollover", rsp.DnsSec.EnableRfc5011KeyRollover)
+	if rsp.DnsSec.DenialOfExistence == "NSec3" {
+		d.Set("nsec3_iterations", rsp.DnsSec.NSec3Iterations)
+		d.Set("nsec3_opt_out", rsp.DnsSec.NSec3OptOut)
+		d.Set("nsec3_random_salt_length", rsp.DnsSec.NSec3RandomSaltLength)
+	}
+	return nil
+}
+
+func resourceDnsZoneSigningUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	if d.HasChanges("denial_of_existence", "nsec3_iterations", "nsec3_opt_out", "nsec3_random_salt_length", "enable_rfc5011_key_rollover") {
+		if err := resourceDnsZoneSigningUpdateSettings(d, client); err != nil {
+			return fmt.Errorf("Error updating DNS zone: %s", err)
+		}
+	}
+
+	if d.HasChange("key") {
+		o, n := d.GetChange("key")
+		unmatched := []map[string]interface{}{}
+		for _, element := range o.([]interface{}) {
+			unmatched = append(unmatched, element.(map[string]interface{}))
+		}
+
+		// keys are matched to the existing keys by their settings so that a
+		// reordered list does not replace keys. New keys are added before
+		// the removed keys are deleted
+		keys := []interface{}{}
+		for _, element := range n.([]interface{}) {
+			key := element.(map[string]interface{})
+
+			var existing map[string]interface{}
+			for i, oldKey := range unmatched {
+				if oldKey != nil && resourceDnsZoneSigningKeyMatches(oldKey, key) {
+					existing, unmatched[i] = oldKey, nil
+					break
+				}
+			}
+
+			if existing == nil {
+				id, err := resourceDnsZoneSigningAddKey(d, client, key)
+				if err != nil {
+					return err
+				}
+				key["key_id"] = id
+			} else {
+				key["key_id"] = existing["key_id"]
+				if period := key["rollover_period_days"].(int); period != 0 && period != existing["rollover_period_days"] {
+					rsp, err := client.UpdateSigningKey(&windns.UpdateSigningKeyOptions{
+						ZoneName:       d.Id(),
+						KeyId:          existing["key_id"].(string),
+						RolloverPeriod: period,
+					})
+					if err != nil {
+						return err
+					} else if rsp.Code != http.StatusOK {
+						return fmt.Errorf(rsp.Detail)
+					}
+				}
+			}
+			keys = append(keys, key)
+		}
+
+		for _, oldKey := range unmatched {
+			if oldKey == nil {
+				continue
+			}
+			if err := resourceDnsZoneSigningDeleteKey(d, client, oldKey); err != nil {
+				return err
+			}
+		}
+		d.Set("key", keys)
+	}
+
+	if err := resourceDnsZoneSigningSign(d, client); err != nil {
+		return err
+	}
+
+	return resourceDnsZoneSigningRead(d, meta)
+}
+
+// resourceDnsZoneSigningDelete unsigns the zone and removes its signing keys
+func resourceDnsZoneSigningDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UnsignZone(&windns.SignZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	for _, key := range d.Get("key").([]interface{}) {
+		if err := resourceDnsZoneSigningDeleteKey(d, client, key.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
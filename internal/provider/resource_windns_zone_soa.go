@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsZoneSOA() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneSOACreate,
+		Read:   resourceDnsZoneSOARead,
+		Update: resourceDnsZoneSOAUpdate,
+		Delete: resourceDnsZoneSOADelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"responsible_person": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"refresh_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_delay": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"expire_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"minimum_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"primary_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceDnsZoneSOACreate takes over the existing SOA record of the zone,
+// values that are not configured keep their current setting
+func resourceDnsZoneSOACreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadSOA(&windns.ReadSOAOptions{
+		ZoneName: d.Get("zone").(string),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	} else if rsp.SOA == nil {
+		return fmt.Errorf("zone %s has no SOA record", d.Get("zone").(string))
+	}
+
+	opts := &windns.UpdateSOAOptions{
+		ZoneName:          d.Get("zone").(string),
+		ResponsiblePerson: rsp.SOA.ResponsiblePerson,
+		RefreshInterval:   rsp.SOA.RefreshInterval,
+		RetryDelay:        rsp.SOA.RetryDelay,
+		ExpireLimit:       rsp.SOA.ExpireLimit,
+		MinimumTimeToLive: rsp.SOA.MinimumTimeToLive,
+	}
+	if v, ok := d.GetOk("responsible_person"); ok {
+		opts.ResponsiblePerson = v.(string)
+	}
+	if v, ok := d.GetOk("refresh_interval"); ok {
+		opts.RefreshInterval = v.(int)
+	}
+	if v, ok := d.GetOk("retry_delay"); ok {
+		opts.RetryDelay = v.(int)
+	}
+	if v, ok := d.GetOk("expire_limit"); ok {
+		opts.ExpireLimit = v.(int)
+	}
+	if v, ok := d.GetOk("minimum_ttl"); ok {
+		opts.MinimumTimeToLive = v.(int)
+	}
+
+	rsp, err = client.UpdateSOA(opts)
+	if err != nil {
+		return fmt.Errorf("Error updating DNS zone: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	d.SetId(d.Get("zone").(string))
+	return resourceDnsZoneSOARead(d, meta)
+}
+
+func resourceDnsZoneSOARead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadSOA(&windns.ReadSOAOptions{
+		ZoneName: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if rsp.SOA == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("responsible_person", rsp.SOA.ResponsiblePerson)
+	d.Set("refresh_interval", rsp.SOA.RefreshInterval)
+	d.Set("retry_delay", rsp.SOA.RetryDelay)
+	d.Set("expire_limit", rsp.SOA.ExpireLimit)
+	d.Set("minimum_ttl", rsp.SOA.MinimumTimeToLive)
+	d.Set("primary_server", rsp.SOA.PrimaryServer)
+	d.Set("serial_number", rsp.SOA.SerialNumber)
+	d.Set("ttl", rsp.SOA.TTL)
+	return nil
+}
+
+func resourceDnsZoneSOAUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateSOA(&windns.UpdateSOAOptions{
+		ZoneName:          d.Id(),
+		ResponsiblePerson: d.Get("responsible_person").(string),
+		RefreshInterval:   d.Get("refresh_interval").(int),
+		RetryDelay:        d.Get("retry_delay").(int),
+		ExpireLimit:       d.Get("expire_limit").(int),
+		MinimumTimeToLive: d.Get("minimum_ttl").(int),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating DNS zone: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsZoneSOARead(d, meta)
+}
+
+// resourceDnsZoneSOADelete only removes the SOA record from the state, a
+// zone can not exist without one
+func resourceDnsZoneSOADelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	d.SetId("")
+	return nil
+}
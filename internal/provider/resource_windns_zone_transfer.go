@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDnsZoneTransfer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDnsZoneTransferCreate,
+		Read:   resourceDnsZoneTransferRead,
+		Update: resourceDnsZoneTransferUpdate,
+		Delete: resourceDnsZoneTransferDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDnsZoneSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateZone,
+				DiffSuppressFunc: suppressFQDNDiff,
+			},
+			"secure_secondaries": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NoTransfer",
+				ValidateFunc: validation.StringInSlice([]string{"NoTransfer", "TransferAnyServer", "TransferToZoneNameServer", "TransferToSecureServers"}, false),
+			},
+			"secondary_servers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+				Set: hashIPString,
+			},
+			"notify": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "NoNotify",
+				ValidateFunc: validation.StringInSlice([]string{"NoNotify", "Notify", "NotifyServers"}, false),
+			},
+			"notify_servers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateIPAddress,
+				},
+				Set: hashIPString,
+			},
+		},
+	}
+}
+
+func resourceDnsZoneTransferCreate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	d.SetId(d.Get("zone").(string))
+	return resourceDnsZoneTransferUpdate(d, meta)
+}
+
+func resourceDnsZoneTransferRead(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.ReadZone(&windns.ReadZoneOptions{
+		Name: d.Id(),
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	if len(rsp.Zones) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	zone := rsp.Zones[0]
+	d.Set("secure_secondaries", zone.SecureSecondaries)
+	d.Set("secondary_servers", zone.SecondaryServers)
+	d.Set("notify", zone.Notify)
+	d.Set("notify_servers", zone.NotifyServers)
+	return nil
+}
+
+func resourceDnsZoneTransferUpdate(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateZoneTransfer(&windns.UpdateZoneTransferOptions{
+		Name:              d.Id(),
+		SecureSecondaries: d.Get("secure_secondaries").(string),
+		SecondaryServers:  setToStrings(d.Get("secondary_servers").(*schema.Set)),
+		Notify:            d.Get("notify").(string),
+		NotifyServers:     setToStrings(d.Get("notify_servers").(*schema.Set)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating DNS zone: %s", err)
+	} else if rsp.Code != http.StatusOK {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return resourceDnsZoneTransferRead(d, meta)
+}
+
+// resourceDnsZoneTransferDelete leaves the zone in place and falls back
+// to its most restrictive settings, no transfers and no notifications
+func resourceDnsZoneTransferDelete(d *schema.ResourceData, meta interface{}) error {
+	if meta == nil {
+		return fmt.Errorf("client not created")
+	}
+
+	client := meta.(*windns.Client)
+	rsp, err := client.UpdateZoneTransfer(&windns.UpdateZoneTransferOptions{
+		Name:              d.Id(),
+		SecureSecondaries: "NoTransfer",
+		Notify:            "NoNotify",
+	})
+	if err != nil {
+		return err
+	} else if rsp.Code != http.StatusOK && rsp.Code != http.StatusNotFound {
+		return fmt.Errorf(rsp.Detail)
+	}
+
+	return nil
+}
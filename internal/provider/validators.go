@@ -2,7 +2,10 @@ package provider
 
 import (
 	"fmt"
+	"net"
 	"strings"
+
+	"github.com/bhoriuchi/terraform-provider-windns/windns"
 )
 
 // Credits
@@ -35,6 +38,9 @@ func validateZone(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// validateName validates a record name relative to its zone. Labels are
+// not restricted to host name characters so service names such as
+// _ldap._tcp.dc._msdcs and wildcards are accepted.
 func validateName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if strings.TrimSpace(value) != value || len(value) == 0 {
@@ -45,3 +51,44 @@ func validateName(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validateIPAddress(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if net.ParseIP(value) == nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid IP address: %q", k, value))
+	}
+	return
+}
+
+func validateIPv6Address(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid IPv6 address: %q", k, value))
+	}
+	return
+}
+
+func validateRecordType(v interface{}, k string) (ws []string, errors []error) {
+	if err := windns.ValidateRecordType(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a supported record type (%s) or TYPEnnn: %s", k, strings.Join(windns.RecordTypes(), ", "), err))
+	}
+	return
+}
+
+// validateManagedRecordType rejects the record types maintained by the
+// server, such as SOA and the dnssec types
+func validateManagedRecordType(v interface{}, k string) (ws []string, errors []error) {
+	if err := windns.ValidateManagedRecordType(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a supported record type (%s) or TYPEnnn: %s", k, strings.Join(windns.ManagedRecordTypes(), ", "), err))
+	}
+	return
+}
+
+func validateNetworkID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := windns.ReverseZones(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a network in CIDR notation: %s", k, err))
+	}
+	return
+}
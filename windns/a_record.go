@@ -1,12 +1,7 @@
 package windns
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"html/template"
-
-	"github.com/masterzen/winrm"
 )
 
 // ReadARecordOptions options to read an a record
@@ -15,6 +10,7 @@ type ReadARecordOptions struct {
 	Name      string
 	Address   string
 	ZoneName  string
+	ZoneScope string
 }
 
 // AddARecordOptions options to add an a record
@@ -23,6 +19,7 @@ type AddARecordOptions struct {
 	Name           string
 	Address        string
 	ZoneName       string
+	ZoneScope      string
 	AllowUpdateAny bool
 	CreatePtr      bool
 	TTL            int
@@ -35,6 +32,7 @@ type UpdateARecordOptions struct {
 	Address    string
 	NewAddress string
 	ZoneName   string
+	ZoneScope  string
 	TTL        int
 }
 
@@ -44,6 +42,7 @@ type DeleteARecordOptions struct {
 	Name      string
 	Address   string
 	ZoneName  string
+	ZoneScope string
 }
 
 // ReadARecord reads an A record
@@ -56,28 +55,7 @@ func (c *Client) ReadARecord(opts *ReadARecordOptions) (*Response, error) {
 	}
 
 	opts.DnsServer = c.o.DnsServer
-	w := new(bytes.Buffer)
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
-	tpl := template.Must(template.New("main").Parse(readARecordScript))
-	if err := tpl.Execute(w, opts); err != nil {
-		return nil, err
-	}
-
-	ps := winrm.Powershell(w.String())
-	exitCode, err := c.c.Run(ps, stdout, stderr)
-	if err != nil {
-		return nil, err
-	} else if exitCode != 0 {
-		return nil, fmt.Errorf("exit code %d: %s", exitCode, stderr.String())
-	}
-
-	rsp := &Response{}
-	if err := json.Unmarshal(stdout.Bytes(), rsp); err != nil {
-		return nil, err
-	}
-
-	return rsp, nil
+	return c.run(readARecordScript, opts)
 }
 
 // AddARecord adds a new A record
@@ -97,28 +75,7 @@ func (c *Client) AddARecord(opts *AddARecordOptions) (*Response, error) {
 	}
 
 	opts.DnsServer = c.o.DnsServer
-	w := new(bytes.Buffer)
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
-	tpl := template.Must(template.New("main").Parse(addARecordScript))
-	if err := tpl.Execute(w, opts); err != nil {
-		return nil, err
-	}
-
-	ps := winrm.Powershell(w.String())
-	exitCode, err := c.c.Run(ps, stdout, stderr)
-	if err != nil {
-		return nil, err
-	} else if exitCode != 0 {
-		return nil, fmt.Errorf("exit code %d: %s", exitCode, stderr.String())
-	}
-
-	rsp := &Response{}
-	if err := json.Unmarshal(stdout.Bytes(), rsp); err != nil {
-		return nil, err
-	}
-
-	return rsp, nil
+	return c.run(addARecordScript, opts)
 }
 
 // UpdateARecord adds an A record
@@ -134,28 +91,7 @@ func (c *Client) UpdateARecord(opts *UpdateARecordOptions) (*Response, error) {
 	}
 
 	opts.DnsServer = c.o.DnsServer
-	w := new(bytes.Buffer)
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
-	tpl := template.Must(template.New("main").Parse(updateARecordScript))
-	if err := tpl.Execute(w, opts); err != nil {
-		return nil, err
-	}
-
-	ps := winrm.Powershell(w.String())
-	exitCode, err := c.c.Run(ps, stdout, stderr)
-	if err != nil {
-		return nil, err
-	} else if exitCode != 0 {
-		return nil, fmt.Errorf("exit code %d: %s", exitCode, stderr.String())
-	}
-
-	rsp := &Response{}
-	if err := json.Unmarshal(stdout.Bytes(), rsp); err != nil {
-		return nil, err
-	}
-
-	return rsp, nil
+	return c.run(updateARecordScript, opts)
 }
 
 // DeleteARecord deletes an A record
@@ -171,28 +107,7 @@ func (c *Client) DeleteARecord(opts *DeleteARecordOptions) (*Response, error) {
 	}
 
 	opts.DnsServer = c.o.DnsServer
-	w := new(bytes.Buffer)
-	stdout := new(bytes.Buffer)
-	stderr := new(bytes.Buffer)
-	tpl := template.Must(template.New("main").Parse(deleteARecordScript))
-	if err := tpl.Execute(w, opts); err != nil {
-		return nil, err
-	}
-
-	ps := winrm.Powershell(w.String())
-	exitCode, err := c.c.Run(ps, stdout, stderr)
-	if err != nil {
-		return nil, err
-	} else if exitCode != 0 {
-		return nil, fmt.Errorf("exit code %d: %s", exitCode, stderr.String())
-	}
-
-	rsp := &Response{}
-	if err := json.Unmarshal(stdout.Bytes(), rsp); err != nil {
-		return nil, err
-	}
-
-	return rsp, nil
+	return c.run(deleteARecordScript, opts)
 }
 
 const (
@@ -206,6 +121,10 @@ const (
 		RRType       = "A"
 		ErrorAction  = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 		
 	$record = Get-DnsServerResourceRecord @findArgs
 	if ($Error.Count -gt 0) {
@@ -261,6 +180,10 @@ const (
 		RRType       = "A"
 		ErrorAction  = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 	
 	$record = Get-DnsServerResourceRecord @findArgs
 	if ($Error.Count -gt 0) {
@@ -305,6 +228,10 @@ const (
 		Confirm        = $false
 		ErrorAction    = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 	
 	Add-DnsServerResourceRecord @createArgs
 	if ($Errors.Count -gt 0) {
@@ -344,6 +271,10 @@ const (
 		RRType       = "A"
 		ErrorAction  = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 	
 	$record = Get-DnsServerResourceRecord @findArgs
 	if ($Error.Count -gt 0) {
@@ -395,6 +326,10 @@ const (
 		Confirm        = $false
 		ErrorAction    = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$updateArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 	
 	Set-DnsServerResourceRecord @updateArgs
 	if ($Errors.Count -gt 0) {
@@ -434,6 +369,10 @@ const (
 		RRType       = "A"
 		ErrorAction  = "SilentlyContinue"
 	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
 		
 	$record = Get-DnsServerResourceRecord @findArgs
 	if ($Error.Count -gt 0) {
@@ -477,6 +416,10 @@ const (
 		Force        = $true
 	}
 
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
 	$record | Remove-DnsServerResourceRecord @deleteArgs
 	if ($Errors.Count -gt 0) {
 		$res = @{
@@ -0,0 +1,481 @@
+package windns
+
+import (
+	"fmt"
+	"net"
+)
+
+// ReadAAAARecordOptions options to read an aaaa record
+type ReadAAAARecordOptions struct {
+	DnsServer string
+	Name      string
+	Address   string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddAAAARecordOptions options to add an aaaa record
+type AddAAAARecordOptions struct {
+	DnsServer      string
+	Name           string
+	Address        string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	CreatePtr      bool
+	TTL            int
+}
+
+// UpdateAAAARecordOptions options to update an aaaa record
+type UpdateAAAARecordOptions struct {
+	DnsServer  string
+	Name       string
+	Address    string
+	NewAddress string
+	ZoneName   string
+	ZoneScope  string
+	TTL        int
+}
+
+// DeleteAAAARecordOptions options to delete an aaaa record
+type DeleteAAAARecordOptions struct {
+	DnsServer string
+	Name      string
+	Address   string
+	ZoneName  string
+	ZoneScope string
+}
+
+// canonicalIPv6 returns the compressed form of an IPv6 address so that
+// equivalent spellings are sent to the server identically
+func canonicalIPv6(addr string) (string, error) {
+	if addr == "" {
+		return "", nil
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil || ip.To4() != nil {
+		return "", fmt.Errorf("%q is not a valid IPv6 address", addr)
+	}
+	return ip.String(), nil
+}
+
+// ReadAAAARecord reads an AAAA record
+func (c *Client) ReadAAAARecord(opts *ReadAAAARecordOptions) (*Response, error) {
+	var err error
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Address, err = canonicalIPv6(opts.Address); err != nil {
+		return nil, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readAAAARecordScript, opts)
+}
+
+// AddAAAARecord adds a new AAAA record
+func (c *Client) AddAAAARecord(opts *AddAAAARecordOptions) (*Response, error) {
+	var err error
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Address == "" {
+		return nil, fmt.Errorf(`required value "address" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Address, err = canonicalIPv6(opts.Address); err != nil {
+		return nil, err
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addAAAARecordScript, opts)
+}
+
+// UpdateAAAARecord updates an AAAA record
+func (c *Client) UpdateAAAARecord(opts *UpdateAAAARecordOptions) (*Response, error) {
+	var err error
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Address == "" {
+		return nil, fmt.Errorf(`required value "address" not specified`)
+	}
+	if opts.Address, err = canonicalIPv6(opts.Address); err != nil {
+		return nil, err
+	}
+	if opts.NewAddress, err = canonicalIPv6(opts.NewAddress); err != nil {
+		return nil, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateAAAARecordScript, opts)
+}
+
+// DeleteAAAARecord deletes an AAAA record
+func (c *Client) DeleteAAAARecord(opts *DeleteAAAARecordOptions) (*Response, error) {
+	var err error
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Address == "" {
+		return nil, fmt.Errorf(`required value "address" not specified`)
+	}
+	if opts.Address, err = canonicalIPv6(opts.Address); err != nil {
+		return nil, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteAAAARecordScript, opts)
+}
+
+const (
+	readAAAARecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "AAAA"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$addr = $_.RecordData.IPv6Address
+		if ([string]::IsNullOrEmpty("{{.Address}}") -or $addr.Equals([ipaddress]"{{.Address}}")) {
+			$records += @{
+				type = "AAAA"
+				name = $_.HostName
+				data = $addr.IPAddressToString
+				zone = "{{.ZoneName}}"
+				ttl  = $_.TimeToLive.TotalSeconds
+			}
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addAAAARecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "AAAA"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.IPv6Address.Equals([ipaddress]"{{.Address}}")
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		AAAA           = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		IPv6Address    = "{{.Address}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		CreatePtr      = ${{.CreatePtr}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "AAAA"
+		name = "{{.Name}}"
+		data = "{{.Address}}"
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateAAAARecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "AAAA"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.IPv6Address.Equals([ipaddress]"{{.Address}}")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$newRecord = $record.Clone()
+	if ({{.TTL}} -gt 0) {
+		$newRecord.TimeToLive = [System.TimeSpan]::FromSeconds({{.TTL}})
+	}
+	if (![string]::IsNullOrEmpty("{{.NewAddress}}")) {
+		$newRecord.RecordData.IPv6Address = [ipaddress]"{{.NewAddress}}"
+	}
+
+	$updateArgs = @{
+		NewInputObject = $newRecord
+		OldInputObject = $record
+		ComputerName   = "{{.DnsServer}}"
+		ZoneName       = "{{.ZoneName}}"
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$updateArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Set-DnsServerResourceRecord @updateArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "AAAA"
+		name = $newRecord.HostName
+		zone = "{{.ZoneName}}"
+		data = $newRecord.RecordData.IPv6Address.IPAddressToString
+		ttl  = $newRecord.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code = 200
+		detail = "record updated"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteAAAARecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "AAAA"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.IPv6Address.Equals([ipaddress]"{{.Address}}")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "AAAA"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = $record.RecordData.IPv6Address.IPAddressToString
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
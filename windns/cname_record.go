@@ -0,0 +1,422 @@
+package windns
+
+import (
+	"fmt"
+)
+
+// ReadCNAMERecordOptions options to read a cname record
+type ReadCNAMERecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddCNAMERecordOptions options to add a cname record
+type AddCNAMERecordOptions struct {
+	DnsServer      string
+	Name           string
+	CName          string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// UpdateCNAMERecordOptions options to update a cname record
+type UpdateCNAMERecordOptions struct {
+	DnsServer string
+	Name      string
+	CName     string
+	ZoneName  string
+	ZoneScope string
+	TTL       int
+}
+
+// DeleteCNAMERecordOptions options to delete a cname record
+type DeleteCNAMERecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// ReadCNAMERecord reads a CNAME record
+func (c *Client) ReadCNAMERecord(opts *ReadCNAMERecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readCNAMERecordScript, opts)
+}
+
+// AddCNAMERecord adds a new CNAME record
+func (c *Client) AddCNAMERecord(opts *AddCNAMERecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.CName == "" {
+		return nil, fmt.Errorf(`required value "cname" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addCNAMERecordScript, opts)
+}
+
+// UpdateCNAMERecord updates the alias target and ttl of a CNAME record in place
+func (c *Client) UpdateCNAMERecord(opts *UpdateCNAMERecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateCNAMERecordScript, opts)
+}
+
+// DeleteCNAMERecord deletes a CNAME record
+func (c *Client) DeleteCNAMERecord(opts *DeleteCNAMERecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteCNAMERecordScript, opts)
+}
+
+const (
+	readCNAMERecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "CName"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "CNAME"
+			name = $_.HostName
+			data = $_.RecordData.HostNameAlias
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addCNAMERecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "CName"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		CName          = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		HostNameAlias  = "{{.CName}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "CNAME"
+		name = "{{.Name}}"
+		data = "{{.CName}}"
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateCNAMERecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "CName"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$newRecord = $record.Clone()
+	if ({{.TTL}} -gt 0) {
+		$newRecord.TimeToLive = [System.TimeSpan]::FromSeconds({{.TTL}})
+	}
+	if (![string]::IsNullOrEmpty("{{.CName}}")) {
+		$newRecord.RecordData.HostNameAlias = "{{.CName}}"
+	}
+
+	$updateArgs = @{
+		NewInputObject = $newRecord
+		OldInputObject = $record
+		ComputerName   = "{{.DnsServer}}"
+		ZoneName       = "{{.ZoneName}}"
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$updateArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Set-DnsServerResourceRecord @updateArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "CNAME"
+		name = $newRecord.HostName
+		zone = "{{.ZoneName}}"
+		data = $newRecord.RecordData.HostNameAlias
+		ttl  = $newRecord.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code = 200
+		detail = "record updated"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteCNAMERecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "CName"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "CNAME"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = $record.RecordData.HostNameAlias
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
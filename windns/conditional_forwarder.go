@@ -0,0 +1,191 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddConditionalForwarderOptions options to add a conditional forwarder.
+// The forwarder is stored in active directory when ReplicationScope is set
+type AddConditionalForwarderOptions struct {
+	DnsServer              string
+	Name                   string
+	MasterServers          []string
+	ForwarderTimeout       int
+	UseRecursion           bool
+	ReplicationScope       string
+	DirectoryPartitionName string
+}
+
+// UpdateConditionalForwarderOptions options to update a conditional forwarder
+type UpdateConditionalForwarderOptions struct {
+	DnsServer              string
+	Name                   string
+	MasterServers          []string
+	ForwarderTimeout       int
+	UseRecursion           bool
+	ReplicationScope       string
+	DirectoryPartitionName string
+}
+
+// AddConditionalForwarder adds a new conditional forwarder zone
+func (c *Client) AddConditionalForwarder(opts *AddConditionalForwarderOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addConditionalForwarderScript, opts)
+}
+
+// UpdateConditionalForwarder updates the master servers, timeout, recursion
+// and, for active directory integrated forwarders, the replication scope of
+// a conditional forwarder zone in place
+func (c *Client) UpdateConditionalForwarder(opts *UpdateConditionalForwarderOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateConditionalForwarderScript, opts)
+}
+
+const (
+	addConditionalForwarderScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $zone) {
+		$res = @{
+			code = 400
+			detail = "zone already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Name             = "{{.Name}}"
+		ComputerName     = "{{.DnsServer}}"
+		MasterServers    = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		ForwarderTimeout = {{.ForwarderTimeout}}
+		UseRecursion     = ${{.UseRecursion}}
+		PassThru         = $true
+		ErrorAction      = "SilentlyContinue"
+	}
+
+	{{if .ReplicationScope}}
+	$createArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$createArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	{{end}}
+
+	$zone = Add-DnsServerConditionalForwarderZone @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone created"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateConditionalForwarderScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		Name             = "{{.Name}}"
+		ComputerName     = "{{.DnsServer}}"
+		MasterServers    = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		ForwarderTimeout = {{.ForwarderTimeout}}
+		UseRecursion     = ${{.UseRecursion}}
+		PassThru         = $true
+		ErrorAction      = "SilentlyContinue"
+	}
+
+	{{if .ReplicationScope}}
+	$setArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$setArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	{{end}}
+
+	$zone = Set-DnsServerConditionalForwarderZone @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone updated"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,230 @@
+package windns
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// DS digest types
+const (
+	DigestSHA256 = 2
+	DigestSHA384 = 4
+)
+
+// DNSKEY flags
+const (
+	FlagSecureEntryPoint = 1
+	FlagRevoke           = 128
+	FlagZoneKey          = 256
+)
+
+// dnssecAlgorithms maps the crypto algorithm names used by the DnsServer
+// cmdlets to their dnssec algorithm numbers
+var dnssecAlgorithms = map[string]uint8{
+	"RsaSha1":         5,
+	"RsaSha1NSec3":    7,
+	"RsaSha256":       8,
+	"RsaSha512":       10,
+	"ECDsaP256Sha256": 13,
+	"ECDsaP384Sha384": 14,
+}
+
+// ReadDNSKEYOptions options to read the dnskey records of a zone
+type ReadDNSKEYOptions struct {
+	DnsServer string
+	ZoneName  string
+}
+
+// DNSKEY a dnskey record of a zone, Algorithm holds the algorithm name
+// reported by the server and PublicKey the base64 encoded key
+type DNSKEY struct {
+	Name      string `json:"name"`
+	Flags     int    `json:"flags"`
+	Protocol  int    `json:"protocol"`
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"public_key"`
+	TTL       int    `json:"ttl"`
+}
+
+// AlgorithmNumber returns the dnssec algorithm number of the key
+func (k *DNSKEY) AlgorithmNumber() (uint8, error) {
+	if n, ok := dnssecAlgorithms[k.Algorithm]; ok {
+		return n, nil
+	}
+	return 0, fmt.Errorf("unsupported dnssec algorithm %q", k.Algorithm)
+}
+
+// rdata returns the wire format record data of the key
+func (k *DNSKEY) rdata() ([]byte, error) {
+	algorithm, err := k.AlgorithmNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(k.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %s", err)
+	}
+
+	rdata := make([]byte, 4, 4+len(key))
+	binary.BigEndian.PutUint16(rdata, uint16(k.Flags))
+	rdata[2] = uint8(k.Protocol)
+	rdata[3] = algorithm
+	return append(rdata, key...), nil
+}
+
+// KeyTag returns the key tag of the key as defined in RFC 4034 appendix B
+func (k *DNSKEY) KeyTag() (uint16, error) {
+	rdata, err := k.rdata()
+	if err != nil {
+		return 0, err
+	}
+
+	var ac uint32
+	for i, b := range rdata {
+		if i&1 == 0 {
+			ac += uint32(b) << 8
+		} else {
+			ac += uint32(b)
+		}
+	}
+	ac += ac >> 16 & 0xffff
+	return uint16(ac & 0xffff), nil
+}
+
+// PublishDS reports whether the parent zone should publish a DS record for
+// the key, a revoked key must no longer be trusted as a secure entry point
+func (k *DNSKEY) PublishDS() bool {
+	return k.Flags&FlagSecureEntryPoint != 0 && k.Flags&FlagRevoke == 0
+}
+
+// String returns the key in presentation format
+func (k *DNSKEY) String() string {
+	algorithm, _ := k.AlgorithmNumber()
+	return fmt.Sprintf("%d %d %d %s", k.Flags, k.Protocol, algorithm, k.PublicKey)
+}
+
+// Digest returns the hex encoded DS digest of the key owned by the zone
+// for the digest type
+func (k *DNSKEY) Digest(zone string, digestType int) (string, error) {
+	rdata, err := k.rdata()
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := encodeName(strings.ToLower(zone))
+	if err != nil {
+		return "", err
+	}
+
+	data := append(owner, rdata...)
+	switch digestType {
+	case DigestSHA256:
+		sum := sha256.Sum256(data)
+		return strings.ToUpper(hex.EncodeToString(sum[:])), nil
+	case DigestSHA384:
+		sum := sha512.Sum384(data)
+		return strings.ToUpper(hex.EncodeToString(sum[:])), nil
+	}
+	return "", fmt.Errorf("unsupported digest type %d", digestType)
+}
+
+// DS returns the DS record data of the key owned by the zone in
+// presentation format
+func (k *DNSKEY) DS(zone string, digestType int) (string, error) {
+	tag, err := k.KeyTag()
+	if err != nil {
+		return "", err
+	}
+	algorithm, err := k.AlgorithmNumber()
+	if err != nil {
+		return "", err
+	}
+	digest, err := k.Digest(zone, digestType)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d %d %d %s", tag, algorithm, digestType, digest), nil
+}
+
+// ReadDNSKEY reads the dnskey records at the apex of a zone
+func (c *Client) ReadDNSKEY(opts *ReadDNSKEYOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readDNSKEYScript, opts)
+}
+
+// the server exposes the zone key, revoke and secure entry point flags as
+// booleans, the protocol of a dnskey is always 3
+const readDNSKEYScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "@"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "DnsKey"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$keys = @()
+	$record | ForEach-Object {
+		$flags = 0
+		if ($_.RecordData.ZoneKey) {
+			$flags += 256
+		}
+		if ($_.RecordData.Revoked) {
+			$flags += 128
+		}
+		if ($_.RecordData.SecureEntryPoint) {
+			$flags += 1
+		}
+
+		$keys += @{
+			name       = "{{.ZoneName}}"
+			flags      = $flags
+			protocol   = 3
+			algorithm  = "$($_.RecordData.CryptoAlgorithm)"
+			public_key = "$($_.RecordData.Base64Data)"
+			ttl        = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record found"
+		dnskeys = $keys
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
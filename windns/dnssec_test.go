@@ -0,0 +1,84 @@
+package windns
+
+import "testing"
+
+// the DNSKEY and DS records of the example in RFC 4509 section 2.3
+func rfc4509Key() *DNSKEY {
+	return &DNSKEY{
+		Flags:     256,
+		Protocol:  3,
+		Algorithm: "RsaSha1",
+		PublicKey: "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/" +
+			"2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvx" +
+			"egXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc" +
+			"nOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+	}
+}
+
+func TestDNSKEYDS(t *testing.T) {
+	key := rfc4509Key()
+
+	tag, err := key.KeyTag()
+	if err != nil {
+		t.Fatal(err)
+	} else if tag != 60485 {
+		t.Errorf("KeyTag() = %d, want 60485", tag)
+	}
+
+	want := "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"
+	for _, zone := range []string{"dskey.example.com.", "DSKEY.example.com"} {
+		ds, err := key.DS(zone, DigestSHA256)
+		if err != nil {
+			t.Fatal(err)
+		} else if ds != want {
+			t.Errorf("DS(%q) = %q, want %q", zone, ds, want)
+		}
+	}
+}
+
+// the ECDSA P-384 example of RFC 6605 section 6.2
+func TestDNSKEYDSSHA384(t *testing.T) {
+	key := &DNSKEY{
+		Flags:     257,
+		Protocol:  3,
+		Algorithm: "ECDsaP384Sha384",
+		PublicKey: "xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1" +
+			"w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8" +
+			"/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40",
+	}
+
+	want := "10771 14 4 72D7B62976CE06438E9C0BF319013CF801F09ECC84B8" +
+		"D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6"
+	ds, err := key.DS("example.net.", DigestSHA384)
+	if err != nil {
+		t.Fatal(err)
+	} else if ds != want {
+		t.Errorf("DS() = %q, want %q", ds, want)
+	}
+}
+
+// revoking a key sets a flag covered by the key tag, RFC 5011 section 7
+func TestDNSKEYRevoked(t *testing.T) {
+	key := rfc4509Key()
+	key.Flags |= FlagSecureEntryPoint
+	if !key.PublishDS() {
+		t.Error("PublishDS() of a secure entry point = false")
+	}
+
+	key.Flags |= FlagRevoke
+	if key.PublishDS() {
+		t.Error("PublishDS() of a revoked key = true")
+	}
+
+	tag, err := key.KeyTag()
+	if err != nil {
+		t.Fatal(err)
+	} else if tag != 60485+1+128 {
+		t.Errorf("KeyTag() of the revoked key = %d, want %d", tag, 60485+1+128)
+	}
+
+	key.Flags = FlagZoneKey
+	if key.PublishDS() {
+		t.Error("PublishDS() of a zone signing key = true")
+	}
+}
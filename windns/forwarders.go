@@ -0,0 +1,158 @@
+package windns
+
+import (
+	"fmt"
+)
+
+// ReadForwardersOptions options to read the forwarders of the server
+type ReadForwardersOptions struct {
+	DnsServer string
+}
+
+// UpdateForwardersOptions options to replace the forwarders of the server,
+// IPAddresses are queried in the given order
+type UpdateForwardersOptions struct {
+	DnsServer   string
+	IPAddresses []string
+	Timeout     int
+	UseRootHint bool
+}
+
+// DeleteForwardersOptions options to remove the forwarders of the server
+type DeleteForwardersOptions struct {
+	DnsServer string
+}
+
+// ReadForwarders reads the forwarders of the server
+func (c *Client) ReadForwarders(opts *ReadForwardersOptions) (*Response, error) {
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readForwardersScript, opts)
+}
+
+// UpdateForwarders replaces the forwarders, timeout and root hint setting
+// of the server
+func (c *Client) UpdateForwarders(opts *UpdateForwardersOptions) (*Response, error) {
+	if len(opts.IPAddresses) == 0 {
+		return nil, fmt.Errorf(`required value "ip_addresses" not specified`)
+	}
+	if opts.Timeout < 1 {
+		return nil, fmt.Errorf(`required value "timeout" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateForwardersScript, opts)
+}
+
+// DeleteForwarders removes all forwarders of the server and restores the
+// default timeout and root hint fallback
+func (c *Client) DeleteForwarders(opts *DeleteForwardersOptions) (*Response, error) {
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteForwardersScript, opts)
+}
+
+const (
+	// forwardersObjectScript defines ConvertTo-ForwardersObject which maps
+	// the forwarder settings of a server to the json form of Forwarders
+	forwardersObjectScript = `
+	function ConvertTo-ForwardersObject($forwarder) {
+		return @{
+			dns_server    = "{{.DnsServer}}"
+			ip_addresses  = @($forwarder.IPAddress | Where-Object { $null -ne $_ } | ForEach-Object { $_.IPAddressToString })
+			timeout       = [int]$forwarder.Timeout
+			use_root_hint = [bool]$forwarder.UseRootHint
+		}
+	}
+	`
+
+	readForwardersScript = forwardersObjectScript + `
+	Import-Module DNSServer
+
+	$forwarder = Get-DnsServerForwarder -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "forwarders found"
+		forwarders = ConvertTo-ForwardersObject $forwarder
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateForwardersScript = forwardersObjectScript + `
+	Import-Module DNSServer
+
+	$setArgs = @{
+		IPAddress    = @({{range $i, $ip := .IPAddresses}}{{if $i}}, {{end}}"{{$ip}}"{{end}})
+		Timeout      = {{.Timeout}}
+		UseRootHint  = ${{.UseRootHint}}
+		ComputerName = "{{.DnsServer}}"
+		PassThru     = $true
+		Confirm      = $false
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	$forwarder = Set-DnsServerForwarder @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "forwarders updated"
+		forwarders = ConvertTo-ForwardersObject $forwarder
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteForwardersScript = forwardersObjectScript + `
+	Import-Module DNSServer
+
+	$forwarder = Get-DnsServerForwarder -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$addresses = @($forwarder.IPAddress | Where-Object { $null -ne $_ })
+	if ($addresses.Count -gt 0) {
+		Remove-DnsServerForwarder -IPAddress $addresses -ComputerName "{{.DnsServer}}" -Force -ErrorAction "SilentlyContinue"
+	}
+	if ($Error.Count -eq 0) {
+		$forwarder = Set-DnsServerForwarder -Timeout 3 -UseRootHint $true -ComputerName "{{.DnsServer}}" -PassThru -Confirm:$false -ErrorAction "SilentlyContinue"
+	}
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "forwarders deleted"
+		forwarders = ConvertTo-ForwardersObject $forwarder
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
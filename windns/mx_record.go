@@ -0,0 +1,331 @@
+package windns
+
+import (
+	"fmt"
+)
+
+// ReadMXRecordOptions options to read an mx record
+type ReadMXRecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddMXRecordOptions options to add an mx record
+type AddMXRecordOptions struct {
+	DnsServer      string
+	Name           string
+	Preference     int
+	Exchange       string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// DeleteMXRecordOptions options to delete an mx record
+type DeleteMXRecordOptions struct {
+	DnsServer  string
+	Name       string
+	Preference int
+	Exchange   string
+	ZoneName   string
+	ZoneScope  string
+}
+
+// ReadMXRecord reads the MX records of a name
+func (c *Client) ReadMXRecord(opts *ReadMXRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readMXRecordScript, opts)
+}
+
+// AddMXRecord adds a new MX record
+func (c *Client) AddMXRecord(opts *AddMXRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Exchange == "" {
+		return nil, fmt.Errorf(`required value "exchange" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addMXRecordScript, opts)
+}
+
+// DeleteMXRecord deletes the MX record matching the preference and exchange
+func (c *Client) DeleteMXRecord(opts *DeleteMXRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Exchange == "" {
+		return nil, fmt.Errorf(`required value "exchange" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteMXRecordScript, opts)
+}
+
+const (
+	readMXRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "MX"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "MX"
+			name = $_.HostName
+			data = @{
+				preference = $_.RecordData.Preference
+				exchange   = $_.RecordData.MailExchange
+			}
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addMXRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "MX"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.Preference -eq {{.Preference}} -and
+		$_.RecordData.MailExchange.TrimEnd(".") -eq "{{.Exchange}}".TrimEnd(".")
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		MX             = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		Preference     = {{.Preference}}
+		MailExchange   = "{{.Exchange}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "MX"
+		name = "{{.Name}}"
+		data = @{
+			preference = {{.Preference}}
+			exchange   = "{{.Exchange}}"
+		}
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteMXRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "MX"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.Preference -eq {{.Preference}} -and
+		$_.RecordData.MailExchange.TrimEnd(".") -eq "{{.Exchange}}".TrimEnd(".")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "MX"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = @{
+			preference = $record.RecordData.Preference
+			exchange   = $record.RecordData.MailExchange
+		}
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,317 @@
+package windns
+
+import (
+	"fmt"
+)
+
+// ReadNSRecordOptions options to read an ns record
+type ReadNSRecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddNSRecordOptions options to add an ns record
+type AddNSRecordOptions struct {
+	DnsServer      string
+	Name           string
+	NameServer     string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// DeleteNSRecordOptions options to delete an ns record
+type DeleteNSRecordOptions struct {
+	DnsServer  string
+	Name       string
+	NameServer string
+	ZoneName   string
+	ZoneScope  string
+}
+
+// ReadNSRecord reads the NS records of a name
+func (c *Client) ReadNSRecord(opts *ReadNSRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readNSRecordScript, opts)
+}
+
+// AddNSRecord adds a new NS record
+func (c *Client) AddNSRecord(opts *AddNSRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.NameServer == "" {
+		return nil, fmt.Errorf(`required value "name_server" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addNSRecordScript, opts)
+}
+
+// DeleteNSRecord deletes the NS record pointing at the name server
+func (c *Client) DeleteNSRecord(opts *DeleteNSRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.NameServer == "" {
+		return nil, fmt.Errorf(`required value "name_server" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteNSRecordScript, opts)
+}
+
+const (
+	readNSRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "NS"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "NS"
+			name = $_.HostName
+			data = $_.RecordData.NameServer
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addNSRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "NS"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.NameServer.TrimEnd(".") -eq "{{.NameServer}}".TrimEnd(".")
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		NS             = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		NameServer     = "{{.NameServer}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "NS"
+		name = "{{.Name}}"
+		data = "{{.NameServer}}"
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteNSRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "NS"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.NameServer.TrimEnd(".") -eq "{{.NameServer}}".TrimEnd(".")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "NS"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = $record.RecordData.NameServer
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
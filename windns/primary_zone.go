@@ -0,0 +1,244 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddPrimaryZoneOptions options to add a primary zone. The zone is stored
+// in active directory when ReplicationScope is set and in ZoneFile otherwise.
+// A reverse lookup zone is created from NetworkId when it is set, Name must
+// then hold the zone name the server derives from it
+type AddPrimaryZoneOptions struct {
+	DnsServer              string
+	Name                   string
+	NetworkId              string
+	ReplicationScope       string
+	DirectoryPartitionName string
+	ZoneFile               string
+	LoadExisting           bool
+	DynamicUpdate          string
+}
+
+// UpdatePrimaryZoneOptions options to update a primary zone. The zone is
+// converted between file backed and active directory integrated storage
+// when ReplicationScope is added or removed
+type UpdatePrimaryZoneOptions struct {
+	DnsServer              string
+	Name                   string
+	ReplicationScope       string
+	DirectoryPartitionName string
+	ZoneFile               string
+	DynamicUpdate          string
+}
+
+// AddPrimaryZone adds a new primary zone
+func (c *Client) AddPrimaryZone(opts *AddPrimaryZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	if opts.ReplicationScope == "" && opts.ZoneFile == "" {
+		opts.ZoneFile = opts.Name + ".dns"
+	}
+	if opts.DynamicUpdate == "" {
+		opts.DynamicUpdate = "None"
+		if opts.ReplicationScope != "" {
+			opts.DynamicUpdate = "Secure"
+		}
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addPrimaryZoneScript, opts)
+}
+
+// UpdatePrimaryZone updates the storage and dynamic update settings of a
+// primary zone in place
+func (c *Client) UpdatePrimaryZone(opts *UpdatePrimaryZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.DynamicUpdate == "" {
+		return nil, fmt.Errorf(`required value "dynamic_update" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	if opts.ReplicationScope == "" && opts.ZoneFile == "" {
+		opts.ZoneFile = opts.Name + ".dns"
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updatePrimaryZoneScript, opts)
+}
+
+const (
+	addPrimaryZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $zone) {
+		$res = @{
+			code = 400
+			detail = "zone already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		ComputerName  = "{{.DnsServer}}"
+		DynamicUpdate = "{{.DynamicUpdate}}"
+		PassThru      = $true
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	{{if .NetworkId}}
+	$createArgs.NetworkId = "{{.NetworkId}}"
+	{{else}}
+	$createArgs.Name = "{{.Name}}"
+	{{end}}
+
+	{{if .ReplicationScope}}
+	$createArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$createArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	{{else}}
+	$createArgs.ZoneFile = "{{.ZoneFile}}"
+	$createArgs.LoadExisting = ${{.LoadExisting}}
+	{{end}}
+
+	$zone = Add-DnsServerPrimaryZone @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone created"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updatePrimaryZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$storageArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		Force        = $true
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	$setArgs = @{
+		Name          = "{{.Name}}"
+		ComputerName  = "{{.DnsServer}}"
+		DynamicUpdate = "{{.DynamicUpdate}}"
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	{{if .ReplicationScope}}
+	$storageArgs.ReplicationScope = "{{.ReplicationScope}}"
+	$setArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$storageArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	$setArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	$convert = -not $zone.IsDsIntegrated
+	{{else}}
+	$storageArgs.ZoneFile = "{{.ZoneFile}}"
+	$setArgs.ZoneFile = "{{.ZoneFile}}"
+	$convert = [bool]$zone.IsDsIntegrated
+	{{end}}
+
+	if ($convert) {
+		ConvertTo-DnsServerPrimaryZone @storageArgs
+		if ($Error.Count -gt 0) {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	Set-DnsServerPrimaryZone @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone updated"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,509 @@
+package windns
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// ReadPTRRecordOptions options to read a ptr record
+type ReadPTRRecordOptions struct {
+	DnsServer     string
+	Name          string
+	PtrDomainName string
+	ZoneName      string
+	ZoneScope     string
+}
+
+// AddPTRRecordOptions options to add a ptr record
+type AddPTRRecordOptions struct {
+	DnsServer      string
+	Name           string
+	PtrDomainName  string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// UpdatePTRRecordOptions options to update a ptr record
+type UpdatePTRRecordOptions struct {
+	DnsServer        string
+	Name             string
+	PtrDomainName    string
+	NewPtrDomainName string
+	ZoneName         string
+	ZoneScope        string
+	TTL              int
+}
+
+// DeletePTRRecordOptions options to delete a ptr record
+type DeletePTRRecordOptions struct {
+	DnsServer     string
+	Name          string
+	PtrDomainName string
+	ZoneName      string
+	ZoneScope     string
+}
+
+// ReverseName returns the fully qualified in-addr.arpa or ip6.arpa
+// owner name for an ip address
+func ReverseName(addr string) (string, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return "", fmt.Errorf("%q is not a valid IP address", addr)
+	}
+
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa.", ip4[3], ip4[2], ip4[1], ip4[0]), nil
+	}
+
+	const hex = "0123456789abcdef"
+	labels := make([]string, 0, 32)
+	for i := len(ip) - 1; i >= 0; i-- {
+		labels = append(labels, string(hex[ip[i]&0x0f]), string(hex[ip[i]>>4]))
+	}
+	return strings.Join(labels, ".") + ".ip6.arpa.", nil
+}
+
+// FindReverseZone finds the most specific reverse lookup zone hosted on the
+// server that contains the ip address and returns the zone along with the
+// owner name of the ptr record relative to that zone
+func (c *Client) FindReverseZone(addr string) (zone string, name string, err error) {
+	fqdn, err := ReverseName(addr)
+	if err != nil {
+		return "", "", err
+	}
+
+	rsp, err := c.ListZones(&ListZonesOptions{})
+	if err != nil {
+		return "", "", err
+	} else if rsp.Code != http.StatusOK {
+		return "", "", fmt.Errorf(rsp.Detail)
+	}
+
+	owner := strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	for _, z := range rsp.Zones {
+		if !z.IsReverse {
+			continue
+		}
+		candidate := strings.ToLower(strings.TrimSuffix(z.Name, "."))
+		if owner != candidate && !strings.HasSuffix(owner, "."+candidate) {
+			continue
+		}
+		if len(candidate) > len(zone) {
+			zone = candidate
+		}
+	}
+
+	if zone == "" {
+		return "", "", fmt.Errorf("no reverse lookup zone for %s found on %s", addr, c.o.DnsServer)
+	}
+
+	if owner == zone {
+		return zone + ".", "@", nil
+	}
+	return zone + ".", strings.TrimSuffix(owner, "."+zone), nil
+}
+
+// ReadPTRRecord reads a PTR record
+func (c *Client) ReadPTRRecord(opts *ReadPTRRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readPTRRecordScript, opts)
+}
+
+// AddPTRRecord adds a new PTR record
+func (c *Client) AddPTRRecord(opts *AddPTRRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.PtrDomainName == "" {
+		return nil, fmt.Errorf(`required value "ptr_domain_name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addPTRRecordScript, opts)
+}
+
+// UpdatePTRRecord updates the target and ttl of a PTR record in place
+func (c *Client) UpdatePTRRecord(opts *UpdatePTRRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.PtrDomainName == "" {
+		return nil, fmt.Errorf(`required value "ptr_domain_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updatePTRRecordScript, opts)
+}
+
+// DeletePTRRecord deletes a PTR record
+func (c *Client) DeletePTRRecord(opts *DeletePTRRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.PtrDomainName == "" {
+		return nil, fmt.Errorf(`required value "ptr_domain_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deletePTRRecordScript, opts)
+}
+
+const (
+	readPTRRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Ptr"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$ptr = $_.RecordData.PtrDomainName
+		if ([string]::IsNullOrEmpty("{{.PtrDomainName}}") -or $ptr.TrimEnd(".") -eq "{{.PtrDomainName}}".TrimEnd(".")) {
+			$records += @{
+				type = "PTR"
+				name = $_.HostName
+				data = $ptr
+				zone = "{{.ZoneName}}"
+				ttl  = $_.TimeToLive.TotalSeconds
+			}
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addPTRRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Ptr"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.PtrDomainName.TrimEnd(".") -eq "{{.PtrDomainName}}".TrimEnd(".")
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Ptr            = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		PtrDomainName  = "{{.PtrDomainName}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "PTR"
+		name = "{{.Name}}"
+		data = "{{.PtrDomainName}}"
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updatePTRRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Ptr"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.PtrDomainName.TrimEnd(".") -eq "{{.PtrDomainName}}".TrimEnd(".")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$newRecord = $record.Clone()
+	if ({{.TTL}} -gt 0) {
+		$newRecord.TimeToLive = [System.TimeSpan]::FromSeconds({{.TTL}})
+	}
+	if (![string]::IsNullOrEmpty("{{.NewPtrDomainName}}")) {
+		$newRecord.RecordData.PtrDomainName = "{{.NewPtrDomainName}}"
+	}
+
+	$updateArgs = @{
+		NewInputObject = $newRecord
+		OldInputObject = $record
+		ComputerName   = "{{.DnsServer}}"
+		ZoneName       = "{{.ZoneName}}"
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$updateArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Set-DnsServerResourceRecord @updateArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "PTR"
+		name = $newRecord.HostName
+		zone = "{{.ZoneName}}"
+		data = $newRecord.RecordData.PtrDomainName
+		ttl  = $newRecord.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code = 200
+		detail = "record updated"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deletePTRRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Ptr"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.PtrDomainName.TrimEnd(".") -eq "{{.PtrDomainName}}".TrimEnd(".")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "PTR"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = $record.RecordData.PtrDomainName
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
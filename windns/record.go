@@ -0,0 +1,811 @@
+package windns
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ReadRecordOptions options to read records of any type
+type ReadRecordOptions struct {
+	DnsServer string
+	Name      string
+	Type      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddRecordOptions options to add a record of any type
+type AddRecordOptions struct {
+	DnsServer      string
+	Name           string
+	Type           string
+	Data           string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// UpdateRecordOptions options to update the ttl of a record of any type
+type UpdateRecordOptions struct {
+	DnsServer string
+	Name      string
+	Type      string
+	Data      string
+	ZoneName  string
+	ZoneScope string
+	TTL       int
+}
+
+// DeleteRecordOptions options to delete a record of any type
+type DeleteRecordOptions struct {
+	DnsServer string
+	Name      string
+	Type      string
+	Data      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// ListRecordsOptions options to list the records of a zone. Only records
+// whose name starts with NamePrefix and whose type is one of Types are
+// returned when they are set
+type ListRecordsOptions struct {
+	DnsServer  string
+	ZoneName   string
+	ZoneScope  string
+	NamePrefix string
+	Types      []string
+}
+
+// recordScriptData the values passed to the generic record scripts
+type recordScriptData struct {
+	DnsServer      string
+	Name           string
+	ZoneName       string
+	ZoneScope      string
+	Type           string
+	Code           uint16
+	RRType         string
+	Params         string
+	Quoted         string
+	Values         string
+	Data           string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// listRecordsScriptData the values passed to the list records script,
+// Formats describes how the data of each known record type is rendered and
+// Algorithms numbers the dnssec algorithms of the server maintained types
+type listRecordsScriptData struct {
+	recordScriptData
+	NamePrefix string
+	Codes      []uint16
+	Formats    []*recordScriptData
+	Algorithms map[string]uint8
+}
+
+func newRecordScriptData(dnsServer, name, zone string, t *recordType) *recordScriptData {
+	data := &recordScriptData{
+		DnsServer: dnsServer,
+		Name:      name,
+		ZoneName:  zone,
+		Type:      t.name,
+		Code:      t.code,
+	}
+
+	if t.native() {
+		params := make([]string, 0, len(t.fields))
+		quoted := []string{}
+		for _, f := range t.fields {
+			params = append(params, f.name)
+			if f.kind == fieldString {
+				quoted = append(quoted, f.name)
+			}
+		}
+		data.RRType = t.name
+		data.Params = strings.Join(params, ",")
+		data.Quoted = strings.Join(quoted, ",")
+	}
+
+	return data
+}
+
+// findRecord returns the presentation data of the existing record whose
+// data is equivalent to data as the server formats it
+func (c *Client) findRecord(name, zone, zoneScope, recordType, data string) (string, *Response, error) {
+	want, err := NormalizeRecordData(recordType, data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	t, err := lookupManagedRecordType(recordType)
+	if err != nil {
+		return "", nil, err
+	}
+
+	script := newRecordScriptData(c.o.DnsServer, name, zone, t)
+	script.ZoneScope = zoneScope
+	rsp, err := c.run(readRecordScript, script)
+	if err != nil || rsp.Code != http.StatusOK {
+		return "", rsp, err
+	}
+
+	for _, record := range rsp.Records {
+		current, ok := record.Data.(string)
+		if !ok {
+			continue
+		}
+		if normalized, err := NormalizeRecordData(recordType, current); err == nil && normalized == want {
+			return current, rsp, nil
+		}
+	}
+
+	return "", &Response{Code: http.StatusNotFound, Detail: "record not found"}, nil
+}
+
+// ReadRecord reads the records of a type, the data of each record is
+// returned in presentation format
+func (c *Client) ReadRecord(opts *ReadRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	t, err := lookupManagedRecordType(opts.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	data := newRecordScriptData(opts.DnsServer, opts.Name, opts.ZoneName, t)
+	data.ZoneScope = opts.ZoneScope
+	rsp, err := c.run(readRecordScript, data)
+	if err != nil || rsp.Code != http.StatusOK {
+		return rsp, err
+	}
+
+	// wire encoded types are returned as RFC 3597 data by the server
+	if !t.native() && len(t.fields) > 0 {
+		for _, record := range rsp.Records {
+			if data, ok := record.Data.(string); ok {
+				if normalized, err := NormalizeRecordData(t.name, data); err == nil {
+					record.Data = normalized
+				}
+			}
+		}
+	}
+
+	return rsp, nil
+}
+
+// AddRecord adds a record of any type from presentation format data. Types
+// without a parameter set are added with RFC 3597 data
+func (c *Client) AddRecord(opts *AddRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Data == "" {
+		return nil, fmt.Errorf(`required value "data" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	t, err := lookupManagedRecordType(opts.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseRecordData(t, opts.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rsp, err := c.findRecord(opts.Name, opts.ZoneName, opts.ZoneScope, opts.Type, opts.Data)
+	if err != nil {
+		return nil, err
+	} else if rsp.Code == http.StatusOK {
+		return &Response{Code: http.StatusBadRequest, Detail: "record already exists"}, nil
+	} else if rsp.Code != http.StatusNotFound {
+		return rsp, nil
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	// long text is stored as multiple character strings, one per line
+	for i, f := range t.fields {
+		if f.kind == fieldText {
+			values[i] = strings.Join(SplitTXT(values[i]), "\r\n")
+		}
+	}
+
+	// types without a parameter set are added from their wire format
+	if !t.native() && len(t.fields) > 0 {
+		encoded, err := encodeRecordFields(t, values)
+		if err != nil {
+			return nil, err
+		}
+		values = []string{encoded}
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	data := newRecordScriptData(opts.DnsServer, opts.Name, opts.ZoneName, t)
+	data.ZoneScope = opts.ZoneScope
+	data.Values = encodeStrings(values)
+	data.AllowUpdateAny = opts.AllowUpdateAny
+	data.TTL = opts.TTL
+	return c.run(addRecordScript, data)
+}
+
+// UpdateRecord updates the ttl of a record of any type in place
+func (c *Client) UpdateRecord(opts *UpdateRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Data == "" {
+		return nil, fmt.Errorf(`required value "data" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	t, err := lookupManagedRecordType(opts.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	current, rsp, err := c.findRecord(opts.Name, opts.ZoneName, opts.ZoneScope, opts.Type, opts.Data)
+	if err != nil || rsp.Code != http.StatusOK {
+		return rsp, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	data := newRecordScriptData(opts.DnsServer, opts.Name, opts.ZoneName, t)
+	data.ZoneScope = opts.ZoneScope
+	data.Data = encodeStrings([]string{current})
+	data.TTL = opts.TTL
+	return c.run(updateRecordScript, data)
+}
+
+// DeleteRecord deletes a record of any type
+func (c *Client) DeleteRecord(opts *DeleteRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Data == "" {
+		return nil, fmt.Errorf(`required value "data" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	t, err := lookupManagedRecordType(opts.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	current, rsp, err := c.findRecord(opts.Name, opts.ZoneName, opts.ZoneScope, opts.Type, opts.Data)
+	if err != nil || rsp.Code != http.StatusOK {
+		return rsp, err
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	data := newRecordScriptData(opts.DnsServer, opts.Name, opts.ZoneName, t)
+	data.ZoneScope = opts.ZoneScope
+	data.Data = encodeStrings([]string{current})
+	return c.run(deleteRecordScript, data)
+}
+
+// ListRecords lists the records of every type in a zone. The name prefix
+// and type filters are evaluated by the server, the data of each record is
+// returned typed for structured types and in presentation format otherwise
+func (c *Client) ListRecords(opts *ListRecordsOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	data := &listRecordsScriptData{
+		recordScriptData: recordScriptData{
+			DnsServer: opts.DnsServer,
+			ZoneName:  opts.ZoneName,
+			ZoneScope: opts.ZoneScope,
+		},
+		NamePrefix: opts.NamePrefix,
+		Algorithms: dnssecAlgorithms,
+	}
+
+	for _, name := range opts.Types {
+		t, err := lookupRecordType(name)
+		if err != nil {
+			return nil, err
+		}
+		data.Codes = append(data.Codes, t.code)
+
+		// a single type the DnsServer module knows by name is filtered by
+		// the server rather than after listing the whole zone
+		if len(opts.Types) == 1 && (t.native() || t.server) {
+			data.RRType = t.name
+		}
+	}
+
+	for _, name := range RecordTypes() {
+		t := recordTypes[name]
+		data.Formats = append(data.Formats, newRecordScriptData("", "", "", t))
+	}
+
+	rsp, err := c.run(listRecordsScript, data)
+	if err != nil || rsp.Code != http.StatusOK {
+		return rsp, err
+	}
+
+	for _, record := range rsp.Records {
+		current, ok := record.Data.(string)
+		if !ok {
+			continue
+		}
+		if typed, err := ParseRecordData(record.Type, current); err == nil {
+			record.Data = typed
+		}
+	}
+
+	return rsp, nil
+}
+
+// formatRecordDataScript renders the RecordData of a record in presentation
+// format using the parameter names of the record type, which default to
+// those of the script data. Records of types without a parameter set are
+// rendered as RFC 3597 data
+const formatRecordDataScript = `
+	function Format-RecordData($record, $params = "{{.Params}}", $quoted = "{{.Quoted}}") {
+		if ([string]::IsNullOrEmpty($params)) {
+			$data = $record.RecordData.Data
+			if ($data -is [byte[]]) {
+				$hex = ($data | ForEach-Object { $_.ToString("x2") }) -join ""
+			}
+			else {
+				$hex = ("$data" -replace "[^0-9a-fA-F]", "").ToLower()
+			}
+			if ($hex.Length -eq 0) {
+				return "\# 0"
+			}
+			return "\# $($hex.Length / 2) $hex"
+		}
+
+		$quoted = $quoted.Split(",")
+		$fields = @()
+		foreach ($p in $params.Split(",")) {
+			$value = $record.RecordData.$p
+			if ($value -is [ipaddress]) {
+				$value = $value.IPAddressToString
+			}
+			$value = "$value" -replace "\r?\n", ""
+			if ($quoted -contains $p) {
+				$value = '"' + ($value -replace '\\', '\\' -replace '"', '\"') + '"'
+			}
+			$fields += $value
+		}
+		return $fields -join " "
+	}
+
+	function Find-Records {
+		$findArgs = @{
+			Name         = "{{.Name}}"
+			ComputerName = "{{.DnsServer}}"
+			ZoneName     = "{{.ZoneName}}"
+			ErrorAction  = "SilentlyContinue"
+		}
+
+		{{if .ZoneScope}}
+		$findArgs.ZoneScope = "{{.ZoneScope}}"
+		{{end}}
+		if (![string]::IsNullOrEmpty("{{.RRType}}")) {
+			$findArgs["RRType"] = "{{.RRType}}"
+		}
+
+		Get-DnsServerResourceRecord @findArgs | Where-Object {
+			![string]::IsNullOrEmpty("{{.RRType}}") -or $_.Type -eq {{.Code}} -or $_.RecordType -eq "{{.Type}}"
+		}
+	}
+`
+
+// serverRecordDataScript renders the RecordData of the record types the
+// server maintains in presentation format, other types return $null. The
+// DnsServer module reports algorithms and record types by name
+const serverRecordDataScript = `
+	$algorithms = @{
+		{{range $name, $number := .Algorithms}}
+		"{{$name}}" = {{$number}}
+		{{end}}
+	}
+	$digestTypes = @{ "Sha1" = 1; "Sha256" = 2; "Sha384" = 4 }
+	$hashAlgorithms = @{ "Sha1" = 1 }
+
+	function Format-Salt($salt) {
+		if ($salt -is [byte[]]) {
+			$salt = ($salt | ForEach-Object { $_.ToString("x2") }) -join ""
+		}
+		if ([string]::IsNullOrEmpty("$salt")) {
+			return "-"
+		}
+		return "$salt".ToUpper()
+	}
+
+	function Format-ServerRecordData($record) {
+		$d = $record.RecordData
+		switch ([int]$record.Type) {
+			6 {
+				return "$($d.PrimaryServer) $($d.ResponsiblePerson) $($d.SerialNumber) $([int]$d.RefreshInterval.TotalSeconds) $([int]$d.RetryDelay.TotalSeconds) $([int]$d.ExpireLimit.TotalSeconds) $([int]$d.MinimumTimeToLive.TotalSeconds)"
+			}
+			43 {
+				return "$($d.KeyTag) $($algorithms["$($d.CryptoAlgorithm)"]) $($digestTypes["$($d.DigestType)"]) $("$($d.Digest)".ToUpper())"
+			}
+			46 {
+				$expiration = $d.SignatureExpiration.ToUniversalTime().ToString("yyyyMMddHHmmss")
+				$inception = $d.SignatureInception.ToUniversalTime().ToString("yyyyMMddHHmmss")
+				return "$("$($d.TypeCovered)".ToUpper()) $($algorithms["$($d.CryptoAlgorithm)"]) $($d.LabelCount) $([int]$d.OriginalTtl.TotalSeconds) $expiration $inception $($d.KeyTag) $($d.NameSigner) $($d.Signature)"
+			}
+			47 {
+				$types = ($d.CoveredRecordTypes | ForEach-Object { "$_".ToUpper() }) -join " "
+				return "$($d.Name) $types"
+			}
+			48 {
+				$flags = 0
+				if ($d.ZoneKey) {
+					$flags += 256
+				}
+				if ($d.Revoked) {
+					$flags += 128
+				}
+				if ($d.SecureEntryPoint) {
+					$flags += 1
+				}
+				return "$flags 3 $($algorithms["$($d.CryptoAlgorithm)"]) $($d.Base64Data)"
+			}
+			50 {
+				$types = ($d.CoveredRecordTypes | ForEach-Object { "$_".ToUpper() }) -join " "
+				return "$($hashAlgorithms["$($d.HashAlgorithm)"]) $([int][bool]$d.OptOut) $($d.Iterations) $(Format-Salt $d.Salt) $($d.NextHashedOwnerName) $types"
+			}
+			51 {
+				return "$($hashAlgorithms["$($d.HashAlgorithm)"]) $([int]$d.Flags) $($d.Iterations) $(Format-Salt $d.Salt)"
+			}
+			65281 {
+				$local = ""
+				if (!$d.Replicate) {
+					$local = "LOCAL "
+				}
+				$servers = ($d.WinsServers | ForEach-Object { "$_" }) -join " "
+				return "$($local)L$([int]$d.LookupTimeout.TotalSeconds) C$([int]$d.CacheTimeout.TotalSeconds) ( $servers )"
+			}
+			65282 {
+				$local = ""
+				if (!$d.Replicate) {
+					$local = "LOCAL "
+				}
+				return "$($local)L$([int]$d.LookupTimeout.TotalSeconds) C$([int]$d.CacheTimeout.TotalSeconds) ( $($d.ResultDomain) )"
+			}
+		}
+		return $null
+	}
+`
+
+const (
+	listRecordsScript = formatRecordDataScript + serverRecordDataScript + `
+	Import-Module DNSServer
+
+	$formats = @{
+		{{range .Formats}}
+		{{.Code}} = @{ type = "{{.Type}}"; params = "{{.Params}}"; quoted = "{{.Quoted}}" }
+		{{end}}
+	}
+	$codes = @({{range $i, $c := .Codes}}{{if $i}}, {{end}}{{$c}}{{end}})
+	$prefix = "{{.NamePrefix}}"
+
+	$listArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$listArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+	{{if .RRType}}
+	$listArgs.RRType = "{{.RRType}}"
+	{{end}}
+
+	$found = Get-DnsServerResourceRecord @listArgs | Where-Object {
+		($codes.Count -eq 0 -or $codes -contains [int]$_.Type) -and
+		$_.HostName.StartsWith($prefix, [System.StringComparison]::OrdinalIgnoreCase)
+	}
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$found | ForEach-Object {
+		$format = $formats[[int]$_.Type]
+		if ($null -eq $format) {
+			$format = @{ type = "TYPE$([int]$_.Type)"; params = ""; quoted = "" }
+		}
+
+		$data = Format-ServerRecordData $_
+		if ($null -eq $data) {
+			$data = Format-RecordData $_ $format.params $format.quoted
+		}
+
+		$timestamp = ""
+		if ($null -ne $_.Timestamp) {
+			$timestamp = $_.Timestamp.ToUniversalTime().ToString("o")
+		}
+
+		$records += @{
+			type      = $format.type
+			name      = $_.HostName
+			data      = $data
+			zone      = "{{.ZoneName}}"
+			ttl       = $_.TimeToLive.TotalSeconds
+			timestamp = $timestamp
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "records found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	readRecordScript = formatRecordDataScript + `
+	Import-Module DNSServer
+
+	$record = Find-Records
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "{{.Type}}"
+			name = $_.HostName
+			data = Format-RecordData $_
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addRecordScript = decodeStringsScript + formatRecordDataScript + `
+	Import-Module DNSServer
+
+	$values = ConvertFrom-HexStrings "{{.Values}}"
+	$params = "{{.Params}}"
+
+	$createArgs = @{
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	if ([string]::IsNullOrEmpty($params)) {
+		$createArgs["Type"] = [uint16]{{.Code}}
+		$createArgs["RecordData"] = $values[0]
+	}
+	else {
+		$createArgs["{{.Type}}"] = $true
+		$names = $params.Split(",")
+		for ($i = 0; $i -lt $names.Count; $i++) {
+			$createArgs[$names[$i]] = $values[$i]
+		}
+	}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	Find-Records | ForEach-Object {
+		$records += @{
+			type = "{{.Type}}"
+			name = $_.HostName
+			data = Format-RecordData $_
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateRecordScript = decodeStringsScript + formatRecordDataScript + `
+	Import-Module DNSServer
+
+	$target = (ConvertFrom-HexStrings "{{.Data}}")[0]
+	$record = Find-Records | Where-Object {
+		(Format-RecordData $_) -ceq $target
+	} | Select-Object -First 1
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+	$Error.Clear()
+
+	$newRecord = $record.Clone()
+	if ({{.TTL}} -gt 0) {
+		$newRecord.TimeToLive = [System.TimeSpan]::FromSeconds({{.TTL}})
+	}
+
+	$updateArgs = @{
+		NewInputObject = $newRecord
+		OldInputObject = $record
+		ComputerName   = "{{.DnsServer}}"
+		ZoneName       = "{{.ZoneName}}"
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$updateArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Set-DnsServerResourceRecord @updateArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "{{.Type}}"
+		name = $newRecord.HostName
+		zone = "{{.ZoneName}}"
+		data = Format-RecordData $newRecord
+		ttl  = $newRecord.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code = 200
+		detail = "record updated"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteRecordScript = decodeStringsScript + formatRecordDataScript + `
+	Import-Module DNSServer
+
+	$target = (ConvertFrom-HexStrings "{{.Data}}")[0]
+	$record = Find-Records | Where-Object {
+		(Format-RecordData $_) -ceq $target
+	} | Select-Object -First 1
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+	$Error.Clear()
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "{{.Type}}"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = Format-RecordData $record
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,118 @@
+package windns
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MXData mx record data
+type MXData struct {
+	Preference int    `json:"preference"`
+	Exchange   string `json:"exchange"`
+}
+
+// String returns the record data in presentation format
+func (d *MXData) String() string {
+	return formatRecordData(recordTypes["MX"], []string{
+		strconv.Itoa(d.Preference),
+		d.Exchange,
+	})
+}
+
+// SRVData srv record data
+type SRVData struct {
+	Priority int    `json:"priority"`
+	Weight   int    `json:"weight"`
+	Port     int    `json:"port"`
+	Target   string `json:"target"`
+}
+
+// String returns the record data in presentation format
+func (d *SRVData) String() string {
+	return formatRecordData(recordTypes["SRV"], []string{
+		strconv.Itoa(d.Priority),
+		strconv.Itoa(d.Weight),
+		strconv.Itoa(d.Port),
+		d.Target,
+	})
+}
+
+// TXTData txt record data, a txt record holds one or more character
+// strings of at most 255 bytes each
+type TXTData struct {
+	Strings []string `json:"strings"`
+}
+
+// Text returns the character strings of the record joined together
+func (t *TXTData) Text() string {
+	return strings.Join(t.Strings, "")
+}
+
+// String returns the record data in presentation format
+func (t *TXTData) String() string {
+	return t.Text()
+}
+
+// HINFOData hinfo record data
+type HINFOData struct {
+	CPU             string `json:"cpu"`
+	OperatingSystem string `json:"operating_system"`
+}
+
+// String returns the record data in presentation format
+func (d *HINFOData) String() string {
+	return formatRecordData(recordTypes["HINFO"], []string{
+		d.CPU,
+		d.OperatingSystem,
+	})
+}
+
+// RPData rp record data
+type RPData struct {
+	Mailbox   string `json:"mailbox"`
+	TXTDomain string `json:"txt_domain"`
+}
+
+// String returns the record data in presentation format
+func (d *RPData) String() string {
+	return formatRecordData(recordTypes["RP"], []string{
+		d.Mailbox,
+		d.TXTDomain,
+	})
+}
+
+// AFSDBData afsdb record data
+type AFSDBData struct {
+	SubType    int    `json:"subtype"`
+	ServerName string `json:"server_name"`
+}
+
+// String returns the record data in presentation format
+func (d *AFSDBData) String() string {
+	return formatRecordData(recordTypes["AFSDB"], []string{
+		strconv.Itoa(d.SubType),
+		d.ServerName,
+	})
+}
+
+// NAPTRData naptr record data
+type NAPTRData struct {
+	Order       int    `json:"order"`
+	Preference  int    `json:"preference"`
+	Flags       string `json:"flags"`
+	Services    string `json:"services"`
+	Regexp      string `json:"regexp"`
+	Replacement string `json:"replacement"`
+}
+
+// String returns the record data in presentation format
+func (d *NAPTRData) String() string {
+	return formatRecordData(recordTypes["NAPTR"], []string{
+		strconv.Itoa(d.Order),
+		strconv.Itoa(d.Preference),
+		d.Flags,
+		d.Services,
+		d.Regexp,
+		d.Replacement,
+	})
+}
@@ -0,0 +1,622 @@
+package windns
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fieldKind describes how a field of record data is parsed and compared
+type fieldKind int
+
+const (
+	fieldName fieldKind = iota
+	fieldIPv4
+	fieldIPv6
+	fieldInt
+	fieldString
+	fieldText
+	// the kinds below only occur in the data of server maintained types
+	fieldUint32
+	fieldToken
+	fieldTypes
+)
+
+// recordField a field of record data. For types with a parameter set the
+// name is both the Add-DnsServerResourceRecord parameter and the RecordData
+// property
+type recordField struct {
+	name string
+	kind fieldKind
+}
+
+// recordType describes how a record type maps onto the DnsServer cmdlets.
+// Types with params are added through their own parameter set, all other
+// types go through the -Type/-RecordData parameter set with their fields
+// wire encoded, or with RFC 3597 data when they have no fields
+type recordType struct {
+	name   string
+	code   uint16
+	params bool
+	fields []recordField
+	// data constructs the typed data decoded from per type scripts
+	data func() interface{}
+	// typed converts parsed fields into the typed data of the record type
+	typed func(values []string) interface{}
+	// server types are maintained by the server itself, they can be listed
+	// but not managed
+	server bool
+}
+
+// native reports whether the type has its own parameter set
+func (t *recordType) native() bool {
+	return t.params
+}
+
+// recordTypes the registry of record types known to the client
+var recordTypes = map[string]*recordType{}
+
+func registerRecordType(t *recordType) {
+	recordTypes[t.name] = t
+}
+
+func init() {
+	registerRecordType(&recordType{
+		name:   "A",
+		code:   1,
+		params: true,
+		fields: []recordField{{"IPv4Address", fieldIPv4}},
+	})
+	registerRecordType(&recordType{
+		name:   "NS",
+		code:   2,
+		params: true,
+		fields: []recordField{{"NameServer", fieldName}},
+	})
+	registerRecordType(&recordType{
+		name:   "CNAME",
+		code:   5,
+		params: true,
+		fields: []recordField{{"HostNameAlias", fieldName}},
+	})
+	registerRecordType(&recordType{
+		name:   "PTR",
+		code:   12,
+		params: true,
+		fields: []recordField{{"PtrDomainName", fieldName}},
+	})
+	registerRecordType(&recordType{
+		name:   "HINFO",
+		code:   13,
+		params: true,
+		fields: []recordField{
+			{"Cpu", fieldString},
+			{"OperatingSystem", fieldString},
+		},
+		typed: func(v []string) interface{} {
+			return &HINFOData{CPU: v[0], OperatingSystem: v[1]}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "MX",
+		code:   15,
+		params: true,
+		fields: []recordField{
+			{"Preference", fieldInt},
+			{"MailExchange", fieldName},
+		},
+		data: func() interface{} { return &MXData{} },
+		typed: func(v []string) interface{} {
+			return &MXData{Preference: atoi(v[0]), Exchange: v[1]}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "TXT",
+		code:   16,
+		params: true,
+		fields: []recordField{{"DescriptiveText", fieldText}},
+		data:   func() interface{} { return &TXTData{} },
+		typed: func(v []string) interface{} {
+			return &TXTData{Strings: SplitTXT(v[0])}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "RP",
+		code:   17,
+		params: true,
+		fields: []recordField{
+			{"ResponsiblePerson", fieldName},
+			{"Description", fieldName},
+		},
+		typed: func(v []string) interface{} {
+			return &RPData{Mailbox: v[0], TXTDomain: v[1]}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "AFSDB",
+		code:   18,
+		params: true,
+		fields: []recordField{
+			{"SubType", fieldInt},
+			{"ServerName", fieldName},
+		},
+		typed: func(v []string) interface{} {
+			return &AFSDBData{SubType: atoi(v[0]), ServerName: v[1]}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "AAAA",
+		code:   28,
+		params: true,
+		fields: []recordField{{"IPv6Address", fieldIPv6}},
+	})
+	registerRecordType(&recordType{
+		name:   "SRV",
+		code:   33,
+		params: true,
+		fields: []recordField{
+			{"Priority", fieldInt},
+			{"Weight", fieldInt},
+			{"Port", fieldInt},
+			{"DomainName", fieldName},
+		},
+		data: func() interface{} { return &SRVData{} },
+		typed: func(v []string) interface{} {
+			return &SRVData{Priority: atoi(v[0]), Weight: atoi(v[1]), Port: atoi(v[2]), Target: v[3]}
+		},
+	})
+	// the DnsServer module has no NAPTR parameter set, the fields are wire
+	// encoded and added through -Type/-RecordData
+	registerRecordType(&recordType{
+		name: "NAPTR",
+		code: 35,
+		fields: []recordField{
+			{"Order", fieldInt},
+			{"Preference", fieldInt},
+			{"Flags", fieldString},
+			{"Services", fieldString},
+			{"Regexp", fieldString},
+			{"Replacement", fieldName},
+		},
+		typed: func(v []string) interface{} {
+			return &NAPTRData{
+				Order:       atoi(v[0]),
+				Preference:  atoi(v[1]),
+				Flags:       v[2],
+				Services:    v[3],
+				Regexp:      v[4],
+				Replacement: v[5],
+			}
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "DNAME",
+		code:   39,
+		params: true,
+		fields: []recordField{{"DomainNameAlias", fieldName}},
+	})
+
+	// types the server stores but the client has no fields for, these
+	// are managed with RFC 3597 data
+	for name, code := range map[string]uint16{
+		"SSHFP":      44,
+		"TLSA":       52,
+		"SMIMEA":     53,
+		"OPENPGPKEY": 61,
+		"SVCB":       64,
+		"HTTPS":      65,
+		"URI":        256,
+		"CAA":        257,
+	} {
+		registerRecordType(&recordType{name: name, code: code})
+	}
+
+	// types written by the server when a zone is created or signed, and
+	// the WINS lookup types of the server. Their fields parse the data
+	// as the list records script renders it
+	registerRecordType(&recordType{
+		name:   "SOA",
+		code:   6,
+		server: true,
+		fields: []recordField{
+			{"PrimaryServer", fieldName},
+			{"ResponsiblePerson", fieldName},
+			{"SerialNumber", fieldUint32},
+			{"RefreshInterval", fieldUint32},
+			{"RetryDelay", fieldUint32},
+			{"ExpireLimit", fieldUint32},
+			{"MinimumTimeToLive", fieldUint32},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "DS",
+		code:   43,
+		server: true,
+		fields: []recordField{
+			{"KeyTag", fieldInt},
+			{"CryptoAlgorithm", fieldInt},
+			{"DigestType", fieldInt},
+			{"Digest", fieldToken},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "RRSIG",
+		code:   46,
+		server: true,
+		fields: []recordField{
+			{"TypeCovered", fieldToken},
+			{"CryptoAlgorithm", fieldInt},
+			{"LabelCount", fieldInt},
+			{"OriginalTtl", fieldUint32},
+			{"SignatureExpiration", fieldToken},
+			{"SignatureInception", fieldToken},
+			{"KeyTag", fieldInt},
+			{"NameSigner", fieldName},
+			{"Signature", fieldToken},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "NSEC",
+		code:   47,
+		server: true,
+		fields: []recordField{
+			{"Name", fieldName},
+			{"CoveredRecordTypes", fieldTypes},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "DNSKEY",
+		code:   48,
+		server: true,
+		fields: []recordField{
+			{"Flags", fieldInt},
+			{"KeyProtocol", fieldInt},
+			{"CryptoAlgorithm", fieldInt},
+			{"Base64Data", fieldToken},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "NSEC3",
+		code:   50,
+		server: true,
+		fields: []recordField{
+			{"HashAlgorithm", fieldInt},
+			{"Flags", fieldInt},
+			{"Iterations", fieldInt},
+			{"Salt", fieldToken},
+			{"NextHashedOwnerName", fieldToken},
+			{"CoveredRecordTypes", fieldTypes},
+		},
+	})
+	registerRecordType(&recordType{
+		name:   "NSEC3PARAM",
+		code:   51,
+		server: true,
+		fields: []recordField{
+			{"HashAlgorithm", fieldInt},
+			{"Flags", fieldInt},
+			{"Iterations", fieldInt},
+			{"Salt", fieldToken},
+		},
+	})
+	// the WINS types have no standard presentation format, their data is
+	// kept as rendered
+	registerRecordType(&recordType{
+		name:   "WINS",
+		code:   65281,
+		server: true,
+		fields: []recordField{{"Data", fieldText}},
+	})
+	registerRecordType(&recordType{
+		name:   "WINSR",
+		code:   65282,
+		server: true,
+		fields: []recordField{{"Data", fieldText}},
+	})
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// lookupRecordType finds a record type by mnemonic or by its RFC 3597
+// TYPEnnn name, unregistered type codes are treated as unknown types
+func lookupRecordType(name string) (*recordType, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if t, ok := recordTypes[name]; ok {
+		return t, nil
+	}
+
+	if strings.HasPrefix(name, "TYPE") {
+		code, err := strconv.ParseUint(strings.TrimPrefix(name, "TYPE"), 10, 16)
+		if err == nil && code > 0 {
+			for _, t := range recordTypes {
+				if uint64(t.code) == code {
+					return t, nil
+				}
+			}
+			return &recordType{name: name, code: uint16(code)}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported record type %q", name)
+}
+
+// lookupManagedRecordType finds a record type that records can be added,
+// updated and deleted for
+func lookupManagedRecordType(name string) (*recordType, error) {
+	t, err := lookupRecordType(name)
+	if err != nil {
+		return nil, err
+	} else if t.server {
+		return nil, fmt.Errorf("%s records are maintained by the server and can not be managed", t.name)
+	}
+	return t, nil
+}
+
+// RecordTypes returns the mnemonics of all registered record types
+func RecordTypes() []string {
+	names := make([]string, 0, len(recordTypes))
+	for name := range recordTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ManagedRecordTypes returns the mnemonics of the registered record types
+// that are not maintained by the server
+func ManagedRecordTypes() []string {
+	names := []string{}
+	for name, t := range recordTypes {
+		if !t.server {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateRecordType checks that a record type is known to the client
+func ValidateRecordType(name string) error {
+	_, err := lookupRecordType(name)
+	return err
+}
+
+// ValidateManagedRecordType checks that a record type can be managed by
+// the client
+func ValidateManagedRecordType(name string) error {
+	_, err := lookupManagedRecordType(name)
+	return err
+}
+
+// nextToken returns the next whitespace separated token of presentation
+// data along with the remainder. Quoted tokens may contain whitespace and
+// backslash escaped quotes
+func nextToken(s string) (string, string, error) {
+	s = strings.TrimLeft(s, " \t")
+	if !strings.HasPrefix(s, `"`) {
+		i := strings.IndexAny(s, " \t")
+		if i < 0 {
+			return s, "", nil
+		}
+		return s[:i], s[i:], nil
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return b.String(), s[i+1:], nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quoted string in %q", s)
+}
+
+// quoteString renders a character string in presentation format
+func quoteString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// parseRecordData splits presentation format record data into the fields
+// of the record type. Types without fields take RFC 3597 data (\# length
+// hex) and return the hex encoded data as their only field, types with
+// fields accept RFC 3597 data as well
+func parseRecordData(t *recordType, data string) ([]string, error) {
+	data = strings.TrimSpace(data)
+	if len(t.fields) == 0 {
+		return parseUnknownRecordData(data)
+	}
+
+	if strings.HasPrefix(data, `\#`) {
+		values, err := parseUnknownRecordData(data)
+		if err != nil {
+			return nil, err
+		}
+		return decodeRecordFields(t, values[0])
+	}
+
+	var values []string
+	rest := data
+	for i, f := range t.fields {
+		var value string
+		if (f.kind == fieldText || f.kind == fieldTypes) && i == len(t.fields)-1 {
+			value, rest = strings.TrimLeft(rest, " \t"), ""
+		} else {
+			var err error
+			if strings.TrimSpace(rest) == "" {
+				return nil, fmt.Errorf("%s record data %q is missing %s", t.name, data, f.name)
+			}
+			if value, rest, err = nextToken(rest); err != nil {
+				return nil, err
+			}
+		}
+
+		// an NSEC3 record of an empty non-terminal covers no types
+		if value == "" && f.kind != fieldString && f.kind != fieldTypes {
+			return nil, fmt.Errorf("%s record data %q is missing %s", t.name, data, f.name)
+		}
+
+		switch f.kind {
+		case fieldIPv4:
+			ip := net.ParseIP(value)
+			if ip == nil || ip.To4() == nil {
+				return nil, fmt.Errorf("%s record data %q is not a valid IPv4 address", t.name, value)
+			}
+			value = ip.String()
+		case fieldIPv6:
+			ip := net.ParseIP(value)
+			if ip == nil || ip.To4() != nil {
+				return nil, fmt.Errorf("%s record data %q is not a valid IPv6 address", t.name, value)
+			}
+			value = ip.String()
+		case fieldInt:
+			n, err := strconv.ParseUint(value, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("%s record data %q is not a valid %s", t.name, value, f.name)
+			}
+			value = strconv.FormatUint(n, 10)
+		case fieldString:
+			if len(value) > maxTXTStringLength {
+				return nil, fmt.Errorf("%s record data %s is longer than %d bytes", t.name, f.name, maxTXTStringLength)
+			}
+		case fieldName:
+			value = strings.ToLower(strings.TrimSuffix(value, ".")) + "."
+		case fieldUint32:
+			n, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%s record data %q is not a valid %s", t.name, value, f.name)
+			}
+			value = strconv.FormatUint(n, 10)
+		case fieldTypes:
+			value = strings.ToUpper(strings.Join(strings.Fields(value), " "))
+		}
+		values = append(values, value)
+	}
+
+	if strings.TrimSpace(rest) != "" {
+		return nil, fmt.Errorf("%s record data %q has too many fields", t.name, data)
+	}
+
+	return values, nil
+}
+
+func parseUnknownRecordData(data string) ([]string, error) {
+	parts := strings.Fields(data)
+	if len(parts) < 2 || parts[0] != `\#` {
+		return nil, fmt.Errorf(`record data %q must be in RFC 3597 format: \# <length> <hex>`, data)
+	}
+
+	length, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("record data %q has an invalid length", data)
+	}
+
+	encoded := strings.ToLower(strings.Join(parts[2:], ""))
+	b, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("record data %q is not valid hex", data)
+	} else if len(b) != length {
+		return nil, fmt.Errorf("record data %q is %d bytes long but declares %d", data, len(b), length)
+	}
+
+	return []string{encoded}, nil
+}
+
+// formatRecordData joins parsed fields back into presentation format
+func formatRecordData(t *recordType, values []string) string {
+	if len(t.fields) == 0 {
+		if values[0] == "" {
+			return `\# 0`
+		}
+		return fmt.Sprintf(`\# %d %s`, len(values[0])/2, values[0])
+	}
+
+	formatted := make([]string, 0, len(values))
+	for i, v := range values {
+		if t.fields[i].kind == fieldString {
+			v = quoteString(v)
+		}
+		formatted = append(formatted, v)
+	}
+	// an empty type list is the only field that renders as nothing
+	return strings.TrimRight(strings.Join(formatted, " "), " ")
+}
+
+// NormalizeRecordData returns the canonical presentation format of record
+// data so that equivalent spellings compare equal
+func NormalizeRecordData(recordType, data string) (string, error) {
+	t, err := lookupRecordType(recordType)
+	if err != nil {
+		return "", err
+	}
+
+	values, err := parseRecordData(t, data)
+	if err != nil {
+		return "", err
+	}
+
+	return formatRecordData(t, values), nil
+}
+
+// ParseRecordData parses presentation format record data into the typed
+// data of the record type. Types without typed data return the canonical
+// presentation format string
+func ParseRecordData(recordType, data string) (interface{}, error) {
+	t, err := lookupRecordType(recordType)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseRecordData(t, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.typed != nil {
+		return t.typed(values), nil
+	}
+	return formatRecordData(t, values), nil
+}
+
+// UnmarshalJSON decodes structured record data into the data type
+// registered for the record type
+func (r *Record) UnmarshalJSON(b []byte) error {
+	type record Record
+	raw := struct {
+		*record
+		Data json.RawMessage `json:"data"`
+	}{record: (*record)(r)}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	r.Data = nil
+	if len(raw.Data) == 0 {
+		return nil
+	}
+
+	if t, ok := recordTypes[strings.ToUpper(r.Type)]; ok && t.data != nil && raw.Data[0] == '{' {
+		data := t.data()
+		if err := json.Unmarshal(raw.Data, data); err != nil {
+			return err
+		}
+		r.Data = data
+		return nil
+	}
+
+	return json.Unmarshal(raw.Data, &r.Data)
+}
@@ -0,0 +1,208 @@
+package windns
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestNormalizeRecordDataRFC3597(t *testing.T) {
+	cases := []struct {
+		recordType string
+		data       string
+		want       string
+	}{
+		{"SSHFP", `\# 22 01 01 123456789ABCDEF67890123456789ABCDEF67890`, `\# 22 0101123456789abcdef67890123456789abcdef67890`},
+		{"TLSA", `\# 35 030101 d2abde240d7cd3ee6b4b28c54df034b9 7983a1d16e8a410e4561cb106618e971`, `\# 35 030101d2abde240d7cd3ee6b4b28c54df034b97983a1d16e8a410e4561cb106618e971`},
+		{"CAA", `\# 13 0005697373756563612e6f7267`, `\# 13 0005697373756563612e6f7267`},
+		{"SVCB", `\# 3 000100`, `\# 3 000100`},
+		{"HTTPS", `\# 10 0001 00 0001 0003 02 6832`, `\# 10 00010000010003026832`},
+		{"URI", `\# 33 000A0001 6674703a2f2f667470312e6578616d706c652e636f6d2f7075626c6963`, `\# 33 000a00016674703a2f2f667470312e6578616d706c652e636f6d2f7075626c6963`},
+		{"TYPE65280", `\# 0`, `\# 0`},
+		{"type257", `  \# 13 0005697373756563612e6f7267  `, `\# 13 0005697373756563612e6f7267`},
+	}
+
+	for _, c := range cases {
+		got, err := NormalizeRecordData(c.recordType, c.data)
+		if err != nil {
+			t.Errorf("NormalizeRecordData(%s, %q): %s", c.recordType, c.data, err)
+			continue
+		} else if got != c.want {
+			t.Errorf("NormalizeRecordData(%s, %q) = %q, want %q", c.recordType, c.data, got, c.want)
+		}
+
+		// the canonical form is stable and is what ParseRecordData returns
+		again, err := NormalizeRecordData(c.recordType, got)
+		if err != nil || again != got {
+			t.Errorf("NormalizeRecordData(%s, %q) = %q, %v, want it unchanged", c.recordType, got, again, err)
+		}
+		parsed, err := ParseRecordData(c.recordType, c.data)
+		if err != nil || parsed != c.want {
+			t.Errorf("ParseRecordData(%s, %q) = %v, %v, want %q", c.recordType, c.data, parsed, err, c.want)
+		}
+	}
+}
+
+func TestNormalizeRecordDataInvalid(t *testing.T) {
+	cases := []struct {
+		recordType string
+		data       string
+	}{
+		{"CAA", `0 issue "ca.org"`},
+		{"SSHFP", `\# 3 0101`},
+		{"TLSA", `\# 2 zz01`},
+		{"URI", `\#`},
+		{"SVCB", `\# x 000100`},
+		{"NAPTR", `100 10 "S" "SIP+D2U" ""`},
+		{"NAPTR", `100 10 "S" "SIP+D2U" "" . extra`},
+		{"NAPTR", `100 10 "S`},
+		{"NAPTR", `\# 5 0064000a00`},
+		{"BOGUS", `\# 0`},
+	}
+
+	for _, c := range cases {
+		if got, err := NormalizeRecordData(c.recordType, c.data); err == nil {
+			t.Errorf("NormalizeRecordData(%s, %q) = %q, want an error", c.recordType, c.data, got)
+		}
+	}
+}
+
+func TestNAPTRRecordData(t *testing.T) {
+	cases := []struct {
+		data  string
+		want  string
+		typed *NAPTRData
+	}{
+		{
+			`100 10 "" "" "" .`,
+			`100 10 "" "" "" .`,
+			&NAPTRData{Order: 100, Preference: 10, Replacement: "."},
+		},
+		{
+			`100 10 "S" "SIP+D2U" "" _sip._udp.Example.com`,
+			`100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`,
+			&NAPTRData{Order: 100, Preference: 10, Flags: "S", Services: "SIP+D2U", Replacement: "_sip._udp.example.com."},
+		},
+		{
+			`10 0 "u" "E2U+sip" "!^.*$!sip:\"info\"\\x@example.com!" .`,
+			`10 0 "u" "E2U+sip" "!^.*$!sip:\"info\"\\x@example.com!" .`,
+			&NAPTRData{Order: 10, Flags: "u", Services: "E2U+sip", Regexp: `!^.*$!sip:"info"\x@example.com!`, Replacement: "."},
+		},
+	}
+
+	naptr, err := lookupRecordType("NAPTR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range cases {
+		got, err := NormalizeRecordData("NAPTR", c.data)
+		if err != nil {
+			t.Errorf("NormalizeRecordData(NAPTR, %q): %s", c.data, err)
+			continue
+		} else if got != c.want {
+			t.Errorf("NormalizeRecordData(NAPTR, %q) = %q, want %q", c.data, got, c.want)
+		}
+
+		typed, err := ParseRecordData("NAPTR", c.data)
+		if err != nil {
+			t.Errorf("ParseRecordData(NAPTR, %q): %s", c.data, err)
+		} else if !reflect.DeepEqual(typed, c.typed) {
+			t.Errorf("ParseRecordData(NAPTR, %q) = %+v, want %+v", c.data, typed, c.typed)
+		}
+
+		// the fields survive the wire encoding the record is added with
+		values, err := parseRecordData(naptr, c.data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		encoded, err := encodeRecordFields(naptr, values)
+		if err != nil {
+			t.Errorf("encodeRecordFields(%q): %s", c.data, err)
+			continue
+		}
+		decoded, err := decodeRecordFields(naptr, encoded)
+		if err != nil {
+			t.Errorf("decodeRecordFields(%q): %s", encoded, err)
+		} else if !reflect.DeepEqual(decoded, values) {
+			t.Errorf("decodeRecordFields(%q) = %q, want %q", encoded, decoded, values)
+		}
+
+		wire, err := NormalizeRecordData("NAPTR", `\# `+strconv.Itoa(len(encoded)/2)+" "+encoded)
+		if err != nil || wire != c.want {
+			t.Errorf("NormalizeRecordData(NAPTR, RFC 3597 of %q) = %q, %v, want %q", c.data, wire, err, c.want)
+		}
+	}
+}
+
+func TestServerRecordTypes(t *testing.T) {
+	c := &Client{}
+	for _, name := range []string{"SOA", "DS", "RRSIG", "NSEC", "DNSKEY", "NSEC3", "NSEC3PARAM", "WINS", "WINSR", "TYPE6", "dnskey"} {
+		if err := ValidateRecordType(name); err != nil {
+			t.Errorf("ValidateRecordType(%s): %s", name, err)
+		}
+		if err := ValidateManagedRecordType(name); err == nil {
+			t.Errorf("ValidateManagedRecordType(%s) succeeded", name)
+		}
+
+		if _, err := c.AddRecord(&AddRecordOptions{Name: "@", Type: name, Data: `\# 0`, ZoneName: "example.com"}); err == nil {
+			t.Errorf("AddRecord(%s) succeeded", name)
+		}
+		if _, err := c.UpdateRecord(&UpdateRecordOptions{Name: "@", Type: name, Data: `\# 0`, ZoneName: "example.com"}); err == nil {
+			t.Errorf("UpdateRecord(%s) succeeded", name)
+		}
+		if _, err := c.DeleteRecord(&DeleteRecordOptions{Name: "@", Type: name, Data: `\# 0`, ZoneName: "example.com"}); err == nil {
+			t.Errorf("DeleteRecord(%s) succeeded", name)
+		}
+	}
+
+	for _, name := range ManagedRecordTypes() {
+		if recordTypes[name].server {
+			t.Errorf("ManagedRecordTypes() lists %s", name)
+		}
+	}
+}
+
+// the data of the server maintained types as the list records script
+// renders it
+func TestParseServerRecordData(t *testing.T) {
+	cases := []struct {
+		recordType string
+		data       string
+		want       string
+	}{
+		{"SOA", "ns1.Example.com. hostmaster.example.com. 2024010101 900 600 86400 3600", "ns1.example.com. hostmaster.example.com. 2024010101 900 600 86400 3600"},
+		{"DS", "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A", "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"},
+		{"RRSIG", "SOA 8 2 3600 20261101000000 20261018000000 60485 example.com. c2lnbmF0dXJl", "SOA 8 2 3600 20261101000000 20261018000000 60485 example.com. c2lnbmF0dXJl"},
+		{"NSEC", "host.example.com.  A ns SOA RRSIG NSEC DNSKEY", "host.example.com. A NS SOA RRSIG NSEC DNSKEY"},
+		{"DNSKEY", "257 3 8 AwEAAaz/tAm8yTn4Mfeh5eyI96WSVexTBAvkMgJzkKTOiW1vkIbzxeF3", "257 3 8 AwEAAaz/tAm8yTn4Mfeh5eyI96WSVexTBAvkMgJzkKTOiW1vkIbzxeF3"},
+		{"NSEC3", "1 1 10 AABBCCDD 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR A RRSIG", "1 1 10 AABBCCDD 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR A RRSIG"},
+		{"NSEC3", "1 0 0 - 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR ", "1 0 0 - 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR"},
+		{"NSEC3PARAM", "1 0 0 -", "1 0 0 -"},
+		{"WINS", "L2 C900 ( 192.0.2.1 192.0.2.2 )", "L2 C900 ( 192.0.2.1 192.0.2.2 )"},
+		{"WINSR", "LOCAL L2 C900 ( example.com. )", "LOCAL L2 C900 ( example.com. )"},
+	}
+
+	for _, c := range cases {
+		got, err := ParseRecordData(c.recordType, c.data)
+		if err != nil {
+			t.Errorf("ParseRecordData(%s, %q): %s", c.recordType, c.data, err)
+		} else if got != c.want {
+			t.Errorf("ParseRecordData(%s, %q) = %q, want %q", c.recordType, c.data, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		recordType string
+		data       string
+	}{
+		{"SOA", "ns1.example.com. hostmaster.example.com. 4294967296 900 600 86400 3600"},
+		{"SOA", "ns1.example.com. hostmaster.example.com. 1 900 600 86400"},
+		{"DS", "60485 5 2"},
+		{"DNSKEY", "257 3  AwEAAaz"},
+	} {
+		if got, err := ParseRecordData(c.recordType, c.data); err == nil {
+			t.Errorf("ParseRecordData(%s, %q) = %q, want an error", c.recordType, c.data, got)
+		}
+	}
+}
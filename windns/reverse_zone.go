@@ -0,0 +1,248 @@
+package windns
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+)
+
+// ReverseZone a reverse lookup zone derived from a network. NetworkId is
+// the octet or nibble aligned network the server derives Name from and is
+// empty for RFC 2317 classless zones, which are created by name and
+// delegated from the Parent /24 zone by an NS record at Label and CNAMEs
+type ReverseZone struct {
+	Name      string
+	NetworkId string
+	Parent    string
+	Label     string
+	CNAMEs    []*ReverseCNAME
+}
+
+// ReverseCNAME a CNAME record in the parent /24 zone that points the reverse
+// name of an address into its classless zone
+type ReverseCNAME struct {
+	Name   string
+	Target string
+}
+
+// AddReverseDelegationOptions options to delegate a classless reverse zone
+// from its parent zone
+type AddReverseDelegationOptions struct {
+	DnsServer string
+	Zone      *ReverseZone
+}
+
+// DeleteReverseDelegationOptions options to remove the delegation of a
+// classless reverse zone from its parent zone
+type DeleteReverseDelegationOptions struct {
+	DnsServer string
+	Zone      *ReverseZone
+}
+
+// ReverseZones returns the reverse lookup zones covering a network given
+// in CIDR notation. Networks that do not end on an octet (IPv4) or nibble
+// (IPv6) boundary are covered by one zone per subnet at the next boundary,
+// IPv4 networks longer than /24 get an RFC 2317 classless zone named
+// <first octet>-<prefix length> below the parent /24 zone
+func ReverseZones(cidr string) ([]*ReverseZone, error) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+
+	// a single IPv4 address still gets a classless zone of its own
+	ones, bits := network.Mask.Size()
+	longest := bits - 1
+	if bits == 32 {
+		longest = bits
+	}
+	if ones == 0 || ones > longest {
+		return nil, fmt.Errorf("network %s must have a prefix length between 1 and %d", cidr, longest)
+	}
+
+	step, suffix := 4, "ip6.arpa."
+	if bits == 32 {
+		step, suffix = 8, "in-addr.arpa."
+
+		if ones > 24 {
+			ip := network.IP.To4()
+			parent := fmt.Sprintf("%d.%d.%d.%s", ip[2], ip[1], ip[0], suffix)
+			label := fmt.Sprintf("%d-%d", ip[3], ones)
+			zone := &ReverseZone{
+				Name:   label + "." + parent,
+				Parent: parent,
+				Label:  label,
+			}
+			for i := 0; i < 1<<uint(bits-ones); i++ {
+				name := fmt.Sprintf("%d", int(ip[3])+i)
+				zone.CNAMEs = append(zone.CNAMEs, &ReverseCNAME{
+					Name:   name,
+					Target: name + "." + zone.Name,
+				})
+			}
+			return []*ReverseZone{zone}, nil
+		}
+	}
+
+	boundary := (ones + step - 1) / step * step
+	base := new(big.Int).SetBytes(network.IP)
+	zones := []*ReverseZone{}
+	for i := int64(0); i < 1<<(boundary-ones); i++ {
+		offset := new(big.Int).Lsh(big.NewInt(i), uint(bits-boundary))
+		ip := net.IP(new(big.Int).Add(base, offset).FillBytes(make([]byte, len(network.IP))))
+
+		// the digits covered by the prefix, least significant first
+		labels := []string{}
+		for d := boundary/step - 1; d >= 0; d-- {
+			if step == 8 {
+				labels = append(labels, fmt.Sprintf("%d", ip[d]))
+			} else {
+				labels = append(labels, fmt.Sprintf("%x", (ip[d/2]>>(4*uint(1-d%2)))&0x0f))
+			}
+		}
+
+		zones = append(zones, &ReverseZone{
+			Name:      strings.Join(labels, ".") + "." + suffix,
+			NetworkId: fmt.Sprintf("%s/%d", ip, boundary),
+		})
+	}
+
+	return zones, nil
+}
+
+// AddReverseDelegation adds the NS record and CNAMEs that delegate a
+// classless reverse zone from its parent zone, the name server is the
+// primary server of the classless zone
+func (c *Client) AddReverseDelegation(opts *AddReverseDelegationOptions) (*Response, error) {
+	if opts.Zone == nil || opts.Zone.Parent == "" {
+		return nil, fmt.Errorf("reverse zone is not a classless zone")
+	}
+
+	zone := *opts.Zone
+	zone.Name = strings.TrimSuffix(zone.Name, ".")
+	zone.Parent = strings.TrimSuffix(zone.Parent, ".")
+	opts.Zone = &zone
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addReverseDelegationScript, opts)
+}
+
+// DeleteReverseDelegation removes the NS record and CNAMEs that delegate a
+// classless reverse zone from its parent zone, records that no longer exist
+// are skipped
+func (c *Client) DeleteReverseDelegation(opts *DeleteReverseDelegationOptions) (*Response, error) {
+	if opts.Zone == nil || opts.Zone.Parent == "" {
+		return nil, fmt.Errorf("reverse zone is not a classless zone")
+	}
+
+	zone := *opts.Zone
+	zone.Name = strings.TrimSuffix(zone.Name, ".")
+	zone.Parent = strings.TrimSuffix(zone.Parent, ".")
+	opts.Zone = &zone
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteReverseDelegationScript, opts)
+}
+
+const (
+	addReverseDelegationScript = `
+	Import-Module DNSServer
+
+	$soa = Get-DnsServerResourceRecord -ZoneName "{{.Zone.Name}}" -Name "@" -RRType "SOA" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $soa) {
+		$res = @{
+			code = 404
+			detail = "zone {{.Zone.Name}} not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$nameServer = @($soa)[0].RecordData.PrimaryServer
+	$addArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.Zone.Parent}}"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	Add-DnsServerResourceRecord @addArgs -NS -Name "{{.Zone.Label}}" -NameServer $nameServer
+	{{range .Zone.CNAMEs}}
+	if ($Error.Count -eq 0) {
+		Add-DnsServerResourceRecord @addArgs -CName -Name "{{.Name}}" -HostNameAlias "{{.Target}}"
+	}
+	{{end}}
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "parent zone {{.Zone.Parent}} not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail = "delegation added"
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress)"
+	`
+
+	deleteReverseDelegationScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.Zone.Parent}}"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.Zone.Parent}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	$records = @()
+	$records += Get-DnsServerResourceRecord @findArgs -Name "{{.Zone.Label}}" -RRType "NS"
+	{{range .Zone.CNAMEs}}
+	$records += Get-DnsServerResourceRecord @findArgs -Name "{{.Name}}" -RRType "CName" | Where-Object {
+		$_.RecordData.HostNameAlias.TrimEnd(".") -eq "{{.Target}}".TrimEnd(".")
+	}
+	{{end}}
+
+	# names that are already gone report ObjectNotFound
+	$failed = @($Error | Where-Object { $_.CategoryInfo.Category -ne "ObjectNotFound" })
+	if ($failed.Count -eq 0) {
+		$records | Where-Object { $null -ne $_ } | Remove-DnsServerResourceRecord @deleteArgs
+		$failed = @($Error | Where-Object { $_.CategoryInfo.Category -ne "ObjectNotFound" })
+	}
+	if ($failed.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($failed[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "delegation deleted"
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress)"
+	`
+)
@@ -0,0 +1,96 @@
+package windns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReverseZones(t *testing.T) {
+	cases := []struct {
+		cidr  string
+		names []string
+		ids   []string
+	}{
+		{"10.0.0.0/8", []string{"10.in-addr.arpa."}, []string{"10.0.0.0/8"}},
+		{"172.16.0.0/16", []string{"16.172.in-addr.arpa."}, []string{"172.16.0.0/16"}},
+		{"192.0.2.0/24", []string{"2.0.192.in-addr.arpa."}, []string{"192.0.2.0/24"}},
+		{"192.0.2.0/23", []string{"2.0.192.in-addr.arpa.", "3.0.192.in-addr.arpa."}, []string{"192.0.2.0/24", "192.0.3.0/24"}},
+		{"192.0.2.77/26", []string{"64-26.2.0.192.in-addr.arpa."}, []string{""}},
+		{"2001:db8::/32", []string{"8.b.d.0.1.0.0.2.ip6.arpa."}, []string{"2001:db8::/32"}},
+		{"2001:db8::/48", []string{"0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."}, []string{"2001:db8::/48"}},
+		{"2001:db8::/34", []string{
+			"0.8.b.d.0.1.0.0.2.ip6.arpa.",
+			"1.8.b.d.0.1.0.0.2.ip6.arpa.",
+			"2.8.b.d.0.1.0.0.2.ip6.arpa.",
+			"3.8.b.d.0.1.0.0.2.ip6.arpa.",
+		}, []string{"2001:db8::/36", "2001:db8:1000::/36", "2001:db8:2000::/36", "2001:db8:3000::/36"}},
+	}
+
+	for _, c := range cases {
+		zones, err := ReverseZones(c.cidr)
+		if err != nil {
+			t.Errorf("ReverseZones(%q): %s", c.cidr, err)
+			continue
+		}
+
+		names, ids := []string{}, []string{}
+		for _, zone := range zones {
+			names = append(names, zone.Name)
+			ids = append(ids, zone.NetworkId)
+		}
+		if !reflect.DeepEqual(names, c.names) || !reflect.DeepEqual(ids, c.ids) {
+			t.Errorf("ReverseZones(%q) = %v %v, want %v %v", c.cidr, names, ids, c.names, c.ids)
+		}
+	}
+}
+
+func TestReverseZonesClassless(t *testing.T) {
+	cases := []struct {
+		cidr   string
+		name   string
+		label  string
+		first  string
+		last   string
+		cnames int
+	}{
+		{"192.0.2.0/25", "0-25.2.0.192.in-addr.arpa.", "0-25", "0", "127", 128},
+		{"192.0.2.64/26", "64-26.2.0.192.in-addr.arpa.", "64-26", "64", "127", 64},
+		{"192.0.2.5/32", "5-32.2.0.192.in-addr.arpa.", "5-32", "5", "5", 1},
+	}
+
+	for _, c := range cases {
+		zones, err := ReverseZones(c.cidr)
+		if err != nil {
+			t.Errorf("ReverseZones(%q): %s", c.cidr, err)
+			continue
+		} else if len(zones) != 1 {
+			t.Errorf("ReverseZones(%q) returned %d zones, want 1", c.cidr, len(zones))
+			continue
+		}
+
+		zone := zones[0]
+		if zone.Name != c.name || zone.Parent != "2.0.192.in-addr.arpa." || zone.Label != c.label || zone.NetworkId != "" {
+			t.Errorf("ReverseZones(%q) = %+v", c.cidr, zone)
+		}
+		if len(zone.CNAMEs) != c.cnames {
+			t.Errorf("ReverseZones(%q) returned %d CNAMEs, want %d", c.cidr, len(zone.CNAMEs), c.cnames)
+			continue
+		}
+
+		first, last := zone.CNAMEs[0], zone.CNAMEs[len(zone.CNAMEs)-1]
+		if first.Name != c.first || first.Target != c.first+"."+c.name {
+			t.Errorf("ReverseZones(%q) first CNAME = %+v", c.cidr, first)
+		}
+		if last.Name != c.last || last.Target != c.last+"."+c.name {
+			t.Errorf("ReverseZones(%q) last CNAME = %+v", c.cidr, last)
+		}
+	}
+}
+
+func TestReverseZonesInvalid(t *testing.T) {
+	for _, cidr := range []string{"0.0.0.0/0", "::/0", "2001:db8::1/128", "192.0.2.0", "192.0.2.0/33"} {
+		if _, err := ReverseZones(cidr); err == nil {
+			t.Errorf("ReverseZones(%q) succeeded", cidr)
+		}
+	}
+}
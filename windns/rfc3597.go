@@ -0,0 +1,112 @@
+package windns
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodeRecordFields wire encodes parsed fields for types managed through
+// the -Type/-RecordData parameter set and returns the hex encoded data
+func encodeRecordFields(t *recordType, values []string) (string, error) {
+	var b []byte
+	for i, f := range t.fields {
+		value := values[i]
+		switch f.kind {
+		case fieldInt:
+			n, err := strconv.ParseUint(value, 10, 16)
+			if err != nil {
+				return "", err
+			}
+			b = append(b, 0, 0)
+			binary.BigEndian.PutUint16(b[len(b)-2:], uint16(n))
+		case fieldString:
+			if len(value) > maxTXTStringLength {
+				return "", fmt.Errorf("%s %s is longer than %d bytes", t.name, f.name, maxTXTStringLength)
+			}
+			b = append(b, byte(len(value)))
+			b = append(b, value...)
+		case fieldName:
+			name, err := encodeName(value)
+			if err != nil {
+				return "", fmt.Errorf("%s %s %s", t.name, f.name, err)
+			}
+			b = append(b, name...)
+		default:
+			return "", fmt.Errorf("%s %s cannot be wire encoded", t.name, f.name)
+		}
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// encodeName wire encodes an uncompressed domain name
+func encodeName(name string) ([]byte, error) {
+	var b []byte
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		if label == "" {
+			continue
+		}
+		if len(label) > 63 {
+			return nil, fmt.Errorf("label %q is longer than 63 bytes", label)
+		}
+		b = append(b, byte(len(label)))
+		b = append(b, label...)
+	}
+	return append(b, 0), nil
+}
+
+// decodeRecordFields decodes hex encoded wire data into the fields of the
+// record type
+func decodeRecordFields(t *recordType, data string) ([]string, error) {
+	b, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	short := fmt.Errorf("%s record data is truncated", t.name)
+	var values []string
+	for _, f := range t.fields {
+		switch f.kind {
+		case fieldInt:
+			if len(b) < 2 {
+				return nil, short
+			}
+			values = append(values, strconv.Itoa(int(binary.BigEndian.Uint16(b))))
+			b = b[2:]
+		case fieldString:
+			if len(b) < 1 || len(b) < int(b[0])+1 {
+				return nil, short
+			}
+			values = append(values, string(b[1:int(b[0])+1]))
+			b = b[int(b[0])+1:]
+		case fieldName:
+			var labels []string
+			for {
+				if len(b) < 1 {
+					return nil, short
+				}
+				n := int(b[0])
+				if n == 0 {
+					b = b[1:]
+					break
+				}
+				if n > 63 || len(b) < n+1 {
+					return nil, fmt.Errorf("%s record data has an invalid name", t.name)
+				}
+				labels = append(labels, strings.ToLower(string(b[1:n+1])))
+				b = b[n+1:]
+			}
+			values = append(values, strings.Join(labels, ".")+".")
+		default:
+			return nil, fmt.Errorf("%s %s cannot be wire decoded", t.name, f.name)
+		}
+	}
+
+	if len(b) > 0 {
+		return nil, fmt.Errorf("%s record data has %d trailing bytes", t.name, len(b))
+	}
+
+	return values, nil
+}
@@ -0,0 +1,177 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddSecondaryZoneOptions options to add a secondary zone
+type AddSecondaryZoneOptions struct {
+	DnsServer     string
+	Name          string
+	MasterServers []string
+	ZoneFile      string
+	LoadExisting  bool
+}
+
+// UpdateSecondaryZoneOptions options to update a secondary zone
+type UpdateSecondaryZoneOptions struct {
+	DnsServer     string
+	Name          string
+	MasterServers []string
+	ZoneFile      string
+}
+
+// AddSecondaryZone adds a new secondary zone transferred from the master servers
+func (c *Client) AddSecondaryZone(opts *AddSecondaryZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	if opts.ZoneFile == "" {
+		opts.ZoneFile = opts.Name + ".dns"
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addSecondaryZoneScript, opts)
+}
+
+// UpdateSecondaryZone updates the master servers and zone file of a
+// secondary zone in place
+func (c *Client) UpdateSecondaryZone(opts *UpdateSecondaryZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	if opts.ZoneFile == "" {
+		opts.ZoneFile = opts.Name + ".dns"
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateSecondaryZoneScript, opts)
+}
+
+const (
+	addSecondaryZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $zone) {
+		$res = @{
+			code = 400
+			detail = "zone already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Name          = "{{.Name}}"
+		ComputerName  = "{{.DnsServer}}"
+		MasterServers = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		ZoneFile      = "{{.ZoneFile}}"
+		LoadExisting  = ${{.LoadExisting}}
+		PassThru      = $true
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	$zone = Add-DnsServerSecondaryZone @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone created"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateSecondaryZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		Name          = "{{.Name}}"
+		ComputerName  = "{{.DnsServer}}"
+		MasterServers = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		ZoneFile      = "{{.ZoneFile}}"
+		PassThru      = $true
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	$zone = Set-DnsServerSecondaryZone @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone updated"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
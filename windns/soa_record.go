@@ -0,0 +1,163 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadSOAOptions options to read the SOA record of a zone
+type ReadSOAOptions struct {
+	DnsServer string
+	ZoneName  string
+}
+
+// UpdateSOAOptions options to update the SOA record of a zone, intervals
+// are in seconds. The serial number is left to the server
+type UpdateSOAOptions struct {
+	DnsServer         string
+	ZoneName          string
+	ResponsiblePerson string
+	RefreshInterval   int
+	RetryDelay        int
+	ExpireLimit       int
+	MinimumTimeToLive int
+}
+
+// ReadSOA reads the SOA record of a zone
+func (c *Client) ReadSOA(opts *ReadSOAOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readSOAScript, opts)
+}
+
+// UpdateSOA updates the SOA record of a zone in place
+func (c *Client) UpdateSOA(opts *UpdateSOAOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.ResponsiblePerson == "" {
+		return nil, fmt.Errorf(`required value "responsible_person" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateSOAScript, opts)
+}
+
+const (
+	// soaObjectScript defines ConvertTo-SOAObject which maps a SOA record
+	// to the json form of a SOA
+	soaObjectScript = `
+	function ConvertTo-SOAObject($record) {
+		return @{
+			primary_server       = $record.RecordData.PrimaryServer
+			responsible_person   = $record.RecordData.ResponsiblePerson
+			serial_number        = [int64]$record.RecordData.SerialNumber
+			refresh_interval     = $record.RecordData.RefreshInterval.TotalSeconds
+			retry_delay          = $record.RecordData.RetryDelay.TotalSeconds
+			expire_limit         = $record.RecordData.ExpireLimit.TotalSeconds
+			minimum_time_to_live = $record.RecordData.MinimumTimeToLive.TotalSeconds
+			ttl                  = $record.TimeToLive.TotalSeconds
+		}
+	}
+	`
+
+	readSOAScript = soaObjectScript + `
+	Import-Module DNSServer
+
+	$record = Get-DnsServerResourceRecord -ZoneName "{{.ZoneName}}" -Name "@" -RRType "SOA" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $record) {
+		if ($null -eq $record -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "soa record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = @($record)[0]
+	$res = @{
+		code = 200
+		detail = "soa record found"
+		soa = ConvertTo-SOAObject $record
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateSOAScript = soaObjectScript + `
+	Import-Module DNSServer
+
+	$record = Get-DnsServerResourceRecord -ZoneName "{{.ZoneName}}" -Name "@" -RRType "SOA" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $record) {
+		if ($null -eq $record -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "soa record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = @($record)[0]
+	$newRecord = $record.Clone()
+	$newRecord.RecordData.ResponsiblePerson = "{{.ResponsiblePerson}}"
+	$newRecord.RecordData.RefreshInterval = [System.TimeSpan]::FromSeconds({{.RefreshInterval}})
+	$newRecord.RecordData.RetryDelay = [System.TimeSpan]::FromSeconds({{.RetryDelay}})
+	$newRecord.RecordData.ExpireLimit = [System.TimeSpan]::FromSeconds({{.ExpireLimit}})
+	$newRecord.RecordData.MinimumTimeToLive = [System.TimeSpan]::FromSeconds({{.MinimumTimeToLive}})
+
+	$updateArgs = @{
+		NewInputObject = $newRecord
+		OldInputObject = $record
+		ComputerName   = "{{.DnsServer}}"
+		ZoneName       = "{{.ZoneName}}"
+		PassThru       = $true
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	$record = Set-DnsServerResourceRecord @updateArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "soa record updated"
+		soa = ConvertTo-SOAObject $record
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,347 @@
+package windns
+
+import (
+	"fmt"
+)
+
+// ReadSRVRecordOptions options to read an srv record
+type ReadSRVRecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddSRVRecordOptions options to add an srv record
+type AddSRVRecordOptions struct {
+	DnsServer      string
+	Name           string
+	Priority       int
+	Weight         int
+	Port           int
+	Target         string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// DeleteSRVRecordOptions options to delete an srv record
+type DeleteSRVRecordOptions struct {
+	DnsServer string
+	Name      string
+	Priority  int
+	Weight    int
+	Port      int
+	Target    string
+	ZoneName  string
+	ZoneScope string
+}
+
+// ReadSRVRecord reads the SRV records of a name
+func (c *Client) ReadSRVRecord(opts *ReadSRVRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readSRVRecordScript, opts)
+}
+
+// AddSRVRecord adds a new SRV record
+func (c *Client) AddSRVRecord(opts *AddSRVRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Target == "" {
+		return nil, fmt.Errorf(`required value "target" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addSRVRecordScript, opts)
+}
+
+// DeleteSRVRecord deletes the SRV record matching the priority, weight, port and target
+func (c *Client) DeleteSRVRecord(opts *DeleteSRVRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Target == "" {
+		return nil, fmt.Errorf(`required value "target" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteSRVRecordScript, opts)
+}
+
+const (
+	readSRVRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Srv"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "SRV"
+			name = $_.HostName
+			data = @{
+				priority = $_.RecordData.Priority
+				weight   = $_.RecordData.Weight
+				port     = $_.RecordData.Port
+				target   = $_.RecordData.DomainName
+			}
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addSRVRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Srv"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.Priority -eq {{.Priority}} -and
+		$_.RecordData.Weight -eq {{.Weight}} -and
+		$_.RecordData.Port -eq {{.Port}} -and
+		$_.RecordData.DomainName.TrimEnd(".") -eq "{{.Target}}".TrimEnd(".")
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Srv            = $true
+		ZoneName       = "{{.ZoneName}}"
+		Name           = "{{.Name}}"
+		Priority       = {{.Priority}}
+		Weight         = {{.Weight}}
+		Port           = {{.Port}}
+		DomainName     = "{{.Target}}"
+		ComputerName   = "{{.DnsServer}}"
+		AllowUpdateAny = ${{.AllowUpdateAny}}
+		TimeToLive     = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm        = $false
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "SRV"
+		name = "{{.Name}}"
+		data = @{
+			priority = {{.Priority}}
+			weight   = {{.Weight}}
+			port     = {{.Port}}
+			target   = "{{.Target}}"
+		}
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteSRVRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Srv"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		$_.RecordData.Priority -eq {{.Priority}} -and
+		$_.RecordData.Weight -eq {{.Weight}} -and
+		$_.RecordData.Port -eq {{.Port}} -and
+		$_.RecordData.DomainName.TrimEnd(".") -eq "{{.Target}}".TrimEnd(".")
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "SRV"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = @{
+			priority = $record.RecordData.Priority
+			weight   = $record.RecordData.Weight
+			port     = $record.RecordData.Port
+			target   = $record.RecordData.DomainName
+		}
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,191 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddStubZoneOptions options to add a stub zone. The zone is stored in
+// active directory when ReplicationScope is set and in ZoneFile otherwise
+type AddStubZoneOptions struct {
+	DnsServer              string
+	Name                   string
+	MasterServers          []string
+	ReplicationScope       string
+	DirectoryPartitionName string
+	ZoneFile               string
+	LoadExisting           bool
+}
+
+// UpdateStubZoneOptions options to update a stub zone
+type UpdateStubZoneOptions struct {
+	DnsServer              string
+	Name                   string
+	MasterServers          []string
+	ReplicationScope       string
+	DirectoryPartitionName string
+}
+
+// AddStubZone adds a new stub zone
+func (c *Client) AddStubZone(opts *AddStubZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	if opts.ReplicationScope == "" && opts.ZoneFile == "" {
+		opts.ZoneFile = opts.Name + ".dns"
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addStubZoneScript, opts)
+}
+
+// UpdateStubZone updates the master servers and, for active directory
+// integrated zones, the replication scope of a stub zone in place
+func (c *Client) UpdateStubZone(opts *UpdateStubZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if len(opts.MasterServers) == 0 {
+		return nil, fmt.Errorf(`required value "master_servers" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateStubZoneScript, opts)
+}
+
+const (
+	addStubZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $zone) {
+		$res = @{
+			code = 400
+			detail = "zone already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Name          = "{{.Name}}"
+		ComputerName  = "{{.DnsServer}}"
+		MasterServers = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		LoadExisting  = ${{.LoadExisting}}
+		PassThru      = $true
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	{{if .ReplicationScope}}
+	$createArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$createArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	{{else}}
+	$createArgs.ZoneFile = "{{.ZoneFile}}"
+	{{end}}
+
+	$zone = Add-DnsServerStubZone @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone created"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateStubZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		Name          = "{{.Name}}"
+		ComputerName  = "{{.DnsServer}}"
+		MasterServers = @({{range $i, $m := .MasterServers}}{{if $i}}, {{end}}"{{$m}}"{{end}})
+		PassThru      = $true
+		ErrorAction   = "SilentlyContinue"
+	}
+
+	{{if .ReplicationScope}}
+	$setArgs.ReplicationScope = "{{.ReplicationScope}}"
+	{{if .DirectoryPartitionName}}
+	$setArgs.DirectoryPartitionName = "{{.DirectoryPartitionName}}"
+	{{end}}
+	{{end}}
+
+	$zone = Set-DnsServerStubZone @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone updated"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
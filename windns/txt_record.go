@@ -0,0 +1,365 @@
+package windns
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// maxTXTStringLength is the maximum length in bytes of a single
+// character string in a txt record
+const maxTXTStringLength = 255
+
+// ReadTXTRecordOptions options to read a txt record
+type ReadTXTRecordOptions struct {
+	DnsServer string
+	Name      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// AddTXTRecordOptions options to add a txt record
+type AddTXTRecordOptions struct {
+	DnsServer      string
+	Name           string
+	Text           string
+	ZoneName       string
+	ZoneScope      string
+	AllowUpdateAny bool
+	TTL            int
+}
+
+// DeleteTXTRecordOptions options to delete a txt record
+type DeleteTXTRecordOptions struct {
+	DnsServer string
+	Name      string
+	Text      string
+	ZoneName  string
+	ZoneScope string
+}
+
+// SplitTXT splits text into character strings of at most 255 bytes
+// without breaking up multi-byte characters. Text that is not valid UTF-8
+// is split at 255 bytes when no character starts within them
+func SplitTXT(text string) []string {
+	if len(text) <= maxTXTStringLength {
+		return []string{text}
+	}
+
+	var chunks []string
+	for len(text) > maxTXTStringLength {
+		i := maxTXTStringLength
+		for i > 0 && !utf8.RuneStart(text[i]) {
+			i--
+		}
+		if i == 0 {
+			i = maxTXTStringLength
+		}
+		chunks = append(chunks, text[:i])
+		text = text[i:]
+	}
+	return append(chunks, text)
+}
+
+// ReadTXTRecord reads the TXT records of a name
+func (c *Client) ReadTXTRecord(opts *ReadTXTRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readTXTRecordScript, opts)
+}
+
+// AddTXTRecord adds a new TXT record, text longer than 255 bytes is
+// stored as multiple character strings
+func (c *Client) AddTXTRecord(opts *AddTXTRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.Text == "" {
+		return nil, fmt.Errorf(`required value "text" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	if opts.TTL < 1 {
+		opts.TTL = defaultTTL
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addTXTRecordScript, struct {
+		*AddTXTRecordOptions
+		Strings string
+	}{opts, encodeStrings(SplitTXT(opts.Text))})
+}
+
+// DeleteTXTRecord deletes the TXT record holding the text
+func (c *Client) DeleteTXTRecord(opts *DeleteTXTRecordOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.Text == "" {
+		return nil, fmt.Errorf(`required value "text" not specified`)
+	}
+
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteTXTRecordScript, struct {
+		*DeleteTXTRecordOptions
+		Strings string
+	}{opts, encodeStrings(SplitTXT(opts.Text))})
+}
+
+// Windows DNS exposes the character strings of a txt record as a single
+// DescriptiveText value with one string per line
+const (
+	readTXTRecordScript = `
+	Import-Module DNSServer
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Txt"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$records = @()
+	$record | ForEach-Object {
+		$records += @{
+			type = "TXT"
+			name = $_.HostName
+			data = @{
+				strings = @($_.RecordData.DescriptiveText -split "\r?\n")
+			}
+			zone = "{{.ZoneName}}"
+			ttl  = $_.TimeToLive.TotalSeconds
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail  = "record found"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addTXTRecordScript = decodeStringsScript + `
+	Import-Module DNSServer
+
+	$strings = ConvertFrom-HexStrings "{{.Strings}}"
+	$text = $strings -join ""
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Txt"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		(($_.RecordData.DescriptiveText -split "\r?\n") -join "") -ceq $text
+	}
+
+	if ($null -ne $record) {
+		$res = @{
+			code = 400
+			detail = "record already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		Txt             = $true
+		ZoneName        = "{{.ZoneName}}"
+		Name            = "{{.Name}}"
+		DescriptiveText = $strings -join [System.Environment]::NewLine
+		ComputerName    = "{{.DnsServer}}"
+		AllowUpdateAny  = ${{.AllowUpdateAny}}
+		TimeToLive      = [System.TimeSpan]::FromSeconds({{.TTL}})
+		Confirm         = $false
+		ErrorAction     = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$createArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	Add-DnsServerResourceRecord @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "TXT"
+		name = "{{.Name}}"
+		data = @{
+			strings = $strings
+		}
+		zone = "{{.ZoneName}}"
+		ttl  = {{.TTL}}
+	}
+
+	$res = @{
+		code = 200
+		detail = "record created"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteTXTRecordScript = decodeStringsScript + `
+	Import-Module DNSServer
+
+	$text = (ConvertFrom-HexStrings "{{.Strings}}") -join ""
+
+	$findArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		RRType       = "Txt"
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	{{if .ZoneScope}}
+	$findArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record = Get-DnsServerResourceRecord @findArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "record not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$record = $record | Where-Object {
+		(($_.RecordData.DescriptiveText -split "\r?\n") -join "") -ceq $text
+	}
+
+	if ($null -eq $record) {
+		$res = @{
+			code = 404
+			detail = "record not found"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$deleteArgs = @{
+		ComputerName = "{{.DnsServer}}"
+		ZoneName     = "{{.ZoneName}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	{{if .ZoneScope}}
+	$deleteArgs.ZoneScope = "{{.ZoneScope}}"
+	{{end}}
+
+	$record | Remove-DnsServerResourceRecord @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$records = @()
+	$records += @{
+		type = "TXT"
+		name = $record.HostName
+		zone = "{{.ZoneName}}"
+		data = @{
+			strings = @($record.RecordData.DescriptiveText -split "\r?\n")
+		}
+		ttl  = $record.TimeToLive.TotalSeconds
+	}
+
+	$res = @{
+		code    = 200
+		detail  = "record deleted"
+		records = $records
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
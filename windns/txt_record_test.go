@@ -0,0 +1,49 @@
+package windns
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitTXT(t *testing.T) {
+	cases := []struct {
+		name    string
+		text    string
+		lengths []int
+	}{
+		{"short", "v=spf1 -all", []int{11}},
+		{"exact", strings.Repeat("a", 255), []int{255}},
+		{"ascii", strings.Repeat("a", 600), []int{255, 255, 90}},
+		// a 3 byte character straddles the boundary at byte 255
+		{"multibyte boundary", strings.Repeat("a", 254) + "€" + "b", []int{254, 4}},
+		{"multibyte", strings.Repeat("€", 100), []int{255, 45}},
+		// continuation bytes only, no character starts anywhere
+		{"invalid utf-8", strings.Repeat("\x80", 600), []int{255, 255, 90}},
+	}
+
+	for _, c := range cases {
+		chunks := SplitTXT(c.text)
+		if strings.Join(chunks, "") != c.text {
+			t.Errorf("%s: SplitTXT does not preserve the text", c.name)
+		}
+
+		lengths := []int{}
+		for _, chunk := range chunks {
+			lengths = append(lengths, len(chunk))
+			if utf8.ValidString(c.text) && !utf8.ValidString(chunk) {
+				t.Errorf("%s: SplitTXT broke up a character in %q", c.name, chunk)
+			}
+		}
+		if len(lengths) != len(c.lengths) {
+			t.Errorf("%s: SplitTXT lengths = %v, want %v", c.name, lengths, c.lengths)
+			continue
+		}
+		for i := range lengths {
+			if lengths[i] != c.lengths[i] {
+				t.Errorf("%s: SplitTXT lengths = %v, want %v", c.name, lengths, c.lengths)
+				break
+			}
+		}
+	}
+}
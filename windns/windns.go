@@ -1,6 +1,12 @@
 package windns
 
 import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
 	"time"
 
 	"github.com/bhoriuchi/go-winrmkrb5"
@@ -20,20 +26,129 @@ type Client struct {
 	c *winrm.Client
 }
 
-// Record record
+// Record record. Data holds a string for single value record types
+// and a pointer to the typed data struct for structured types. Timestamp
+// is only set when listing records and empty for static records
 type Record struct {
-	Type string      `json:"type"`
-	Name string      `json:"name"`
-	Zone string      `json:"zone"`
-	Data interface{} `json:"data"`
-	TTL  int         `json:"ttl"`
+	Type      string      `json:"type"`
+	Name      string      `json:"name"`
+	Zone      string      `json:"zone"`
+	Data      interface{} `json:"data"`
+	TTL       int         `json:"ttl"`
+	Timestamp string      `json:"timestamp"`
+}
+
+// Zone zone
+type Zone struct {
+	Name                   string   `json:"name"`
+	Type                   string   `json:"type"`
+	IsReverse              bool     `json:"is_reverse"`
+	IsDsIntegrated         bool     `json:"is_ds_integrated"`
+	ReplicationScope       string   `json:"replication_scope"`
+	DirectoryPartitionName string   `json:"directory_partition_name"`
+	DynamicUpdate          string   `json:"dynamic_update"`
+	ZoneFile               string   `json:"zone_file"`
+	MasterServers          []string `json:"master_servers"`
+	LastTransferAttempt    string   `json:"last_transfer_attempt"`
+	LastTransferResult     int      `json:"last_transfer_result"`
+	LastSuccessfulTransfer string   `json:"last_successful_transfer"`
+	LastSuccessfulSOACheck string   `json:"last_successful_soa_check"`
+	ForwarderTimeout       int      `json:"forwarder_timeout"`
+	UseRecursion           bool     `json:"use_recursion"`
+	SecureSecondaries      string   `json:"secure_secondaries"`
+	SecondaryServers       []string `json:"secondary_servers"`
+	Notify                 string   `json:"notify"`
+	NotifyServers          []string `json:"notify_servers"`
+}
+
+// ZoneScope a scope of a zone holding its own set of records
+type ZoneScope struct {
+	Name     string `json:"name"`
+	FileName string `json:"file_name"`
+}
+
+// ZoneDelegation a delegation of a child zone to its name servers
+type ZoneDelegation struct {
+	ChildZoneName string                  `json:"child_zone_name"`
+	NameServers   []*DelegationNameServer `json:"name_servers"`
+}
+
+// DelegationNameServer a name server of a delegation and its glue addresses
+type DelegationNameServer struct {
+	Name        string   `json:"name"`
+	IPAddresses []string `json:"ip_addresses"`
+}
+
+// SOA the start of authority of a zone, intervals are in seconds
+type SOA struct {
+	PrimaryServer     string `json:"primary_server"`
+	ResponsiblePerson string `json:"responsible_person"`
+	SerialNumber      int64  `json:"serial_number"`
+	RefreshInterval   int    `json:"refresh_interval"`
+	RetryDelay        int    `json:"retry_delay"`
+	ExpireLimit       int    `json:"expire_limit"`
+	MinimumTimeToLive int    `json:"minimum_time_to_live"`
+	TTL               int    `json:"ttl"`
+}
+
+// Forwarders the forwarders of a server in the order they are queried,
+// the timeout is in seconds
+type Forwarders struct {
+	DnsServer   string   `json:"dns_server"`
+	IPAddresses []string `json:"ip_addresses"`
+	Timeout     int      `json:"timeout"`
+	UseRootHint bool     `json:"use_root_hint"`
+}
+
+// ZoneAging aging and scavenging settings of a zone, intervals are in hours
+type ZoneAging struct {
+	Name                 string   `json:"name"`
+	AgingEnabled         bool     `json:"aging_enabled"`
+	NoRefreshInterval    int      `json:"no_refresh_interval"`
+	RefreshInterval      int      `json:"refresh_interval"`
+	ScavengeServers      []string `json:"scavenge_servers"`
+	AvailForScavengeTime string   `json:"avail_for_scavenge_time"`
+	NextScavengeTime     string   `json:"next_scavenge_time"`
+}
+
+// SigningKey a dnssec signing key of a zone, the rollover period is in days
+type SigningKey struct {
+	KeyId                 string `json:"key_id"`
+	KeyType               string `json:"key_type"`
+	CryptoAlgorithm       string `json:"crypto_algorithm"`
+	KeyLength             int    `json:"key_length"`
+	KeyStorageProvider    string `json:"key_storage_provider"`
+	RolloverPeriod        int    `json:"rollover_period"`
+	CurrentState          string `json:"current_state"`
+	CurrentRolloverStatus string `json:"current_rollover_status"`
+	LastRolloverTime      string `json:"last_rollover_time"`
+	NextRolloverTime      string `json:"next_rollover_time"`
+}
+
+// DnsSecSettings dnssec settings of a zone
+type DnsSecSettings struct {
+	IsSigned                 bool   `json:"is_signed"`
+	DenialOfExistence        string `json:"denial_of_existence"`
+	NSec3Iterations          int    `json:"nsec3_iterations"`
+	NSec3OptOut              bool   `json:"nsec3_opt_out"`
+	NSec3RandomSaltLength    int    `json:"nsec3_random_salt_length"`
+	EnableRfc5011KeyRollover bool   `json:"enable_rfc5011_key_rollover"`
 }
 
 // Response a response object
 type Response struct {
-	Code    int       `json:"code"`
-	Detail  string    `json:"detail"`
-	Records []*Record `json:"records"`
+	Code        int             `json:"code"`
+	Detail      string          `json:"detail"`
+	Records     []*Record       `json:"records"`
+	Zones       []*Zone         `json:"zones"`
+	Aging       *ZoneAging      `json:"aging"`
+	SigningKeys []*SigningKey   `json:"signing_keys"`
+	DnsSec      *DnsSecSettings `json:"dnssec"`
+	DnsKeys     []*DNSKEY       `json:"dnskeys"`
+	ZoneScopes  []*ZoneScope    `json:"zone_scopes"`
+	Delegation  *ZoneDelegation `json:"delegation"`
+	SOA         *SOA            `json:"soa"`
+	Forwarders  *Forwarders     `json:"forwarders"`
 }
 
 // Options client options
@@ -112,3 +227,58 @@ func NewClient(opts *Options) (*Client, error) {
 
 	return client, nil
 }
+
+// run renders the script template with data, executes it on the dns server
+// and decodes the json response written to stdout
+func (c *Client) run(script string, data interface{}) (*Response, error) {
+	w := new(bytes.Buffer)
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+	tpl := template.Must(template.New("main").Parse(script))
+	if err := tpl.Execute(w, data); err != nil {
+		return nil, err
+	}
+
+	ps := winrm.Powershell(w.String())
+	exitCode, err := c.c.Run(ps, stdout, stderr)
+	if err != nil {
+		return nil, err
+	} else if exitCode != 0 {
+		return nil, fmt.Errorf("exit code %d: %s", exitCode, stderr.String())
+	}
+
+	rsp := &Response{}
+	if err := json.Unmarshal(stdout.Bytes(), rsp); err != nil {
+		return nil, err
+	}
+
+	return rsp, nil
+}
+
+// encodeStrings hex encodes each string and joins them with commas so that
+// arbitrary text survives template rendering unchanged. Scripts decode the
+// value with the ConvertFrom-HexStrings function from decodeStringsScript
+func encodeStrings(values []string) string {
+	encoded := make([]string, 0, len(values))
+	for _, v := range values {
+		encoded = append(encoded, hex.EncodeToString([]byte(v)))
+	}
+	return strings.Join(encoded, ",")
+}
+
+const decodeStringsScript = `
+	function ConvertFrom-HexStrings([string]$value) {
+		$strings = @()
+		if ([string]::IsNullOrEmpty($value)) {
+			return ,$strings
+		}
+		foreach ($h in $value.Split(",")) {
+			$bytes = New-Object byte[] ($h.Length / 2)
+			for ($i = 0; $i -lt $h.Length; $i += 2) {
+				$bytes[$i / 2] = [Convert]::ToByte($h.Substring($i, 2), 16)
+			}
+			$strings += [System.Text.Encoding]::UTF8.GetString($bytes)
+		}
+		return ,$strings
+	}
+`
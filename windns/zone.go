@@ -0,0 +1,205 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadZoneOptions options to read a zone
+type ReadZoneOptions struct {
+	DnsServer string
+	Name      string
+}
+
+// ListZonesOptions options to list zones
+type ListZonesOptions struct {
+	DnsServer string
+}
+
+// DeleteZoneOptions options to delete a zone
+type DeleteZoneOptions struct {
+	DnsServer string
+	Name      string
+}
+
+// ReadZone reads a zone of any type hosted on the server
+func (c *Client) ReadZone(opts *ReadZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readZoneScript, opts)
+}
+
+// ListZones lists the zones of all types hosted on the server
+func (c *Client) ListZones(opts *ListZonesOptions) (*Response, error) {
+	opts.DnsServer = c.o.DnsServer
+	return c.run(listZonesScript, opts)
+}
+
+// DeleteZone deletes a zone and all of its records
+func (c *Client) DeleteZone(opts *DeleteZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteZoneScript, opts)
+}
+
+// zoneObjectScript defines the ConvertTo-ZoneObject function which maps a
+// zone returned by the DnsServer cmdlets to the json form of a Zone
+const zoneObjectScript = `
+	function Format-ZoneTime($time) {
+		if ($null -eq $time) {
+			return ""
+		}
+		return $time.ToUniversalTime().ToString("o")
+	}
+
+	function ConvertTo-ZoneObject($zone) {
+		return @{
+			name                      = $zone.ZoneName
+			type                      = "$($zone.ZoneType)"
+			is_reverse                = [bool]$zone.IsReverseLookupZone
+			is_ds_integrated          = [bool]$zone.IsDsIntegrated
+			replication_scope         = "$($zone.ReplicationScope)"
+			directory_partition_name  = "$($zone.DirectoryPartitionName)"
+			dynamic_update            = "$($zone.DynamicUpdate)"
+			zone_file                 = "$($zone.ZoneFile)"
+			master_servers            = @($zone.MasterServers | ForEach-Object { "$_" })
+			last_transfer_attempt     = Format-ZoneTime $zone.LastZoneTransferAttempt
+			last_transfer_result      = $zone.LastZoneTransferResult
+			last_successful_transfer  = Format-ZoneTime $zone.LastSuccessfulXfr
+			last_successful_soa_check = Format-ZoneTime $zone.LastSuccessfulSOACheck
+			forwarder_timeout         = $zone.ForwarderTimeout
+			use_recursion             = [bool]$zone.UseRecursion
+			secure_secondaries        = "$($zone.SecureSecondaries)"
+			secondary_servers         = @($zone.SecondaryServers | ForEach-Object { "$_" })
+			notify                    = "$($zone.Notify)"
+			notify_servers            = @($zone.NotifyServers | ForEach-Object { "$_" })
+		}
+	}
+`
+
+const (
+	readZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone found"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	listZonesScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zones = Get-DnsServerZone -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$results = @()
+	$zones | ForEach-Object {
+		$results += ConvertTo-ZoneObject $_
+	}
+
+	$res = @{
+		code = 200
+		detail = "zones found"
+		zones = $results
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteZoneScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$deleteArgs = @{
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	Remove-DnsServerZone @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone deleted"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,172 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadZoneAgingOptions options to read the aging settings of a zone
+type ReadZoneAgingOptions struct {
+	DnsServer string
+	Name      string
+}
+
+// UpdateZoneAgingOptions options to update the aging settings of a zone,
+// intervals are in hours. ScavengeServers replaces the servers allowed to
+// scavenge the zone, an empty list allows all of them
+type UpdateZoneAgingOptions struct {
+	DnsServer         string
+	Name              string
+	AgingEnabled      bool
+	NoRefreshInterval int
+	RefreshInterval   int
+	ScavengeServers   []string
+}
+
+// ReadZoneAging reads the aging and scavenging settings of a zone
+func (c *Client) ReadZoneAging(opts *ReadZoneAgingOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readZoneAgingScript, opts)
+}
+
+// UpdateZoneAging updates the aging and scavenging settings of a zone
+func (c *Client) UpdateZoneAging(opts *UpdateZoneAgingOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.NoRefreshInterval < 1 {
+		return nil, fmt.Errorf(`required value "no_refresh_interval" not specified`)
+	}
+	if opts.RefreshInterval < 1 {
+		return nil, fmt.Errorf(`required value "refresh_interval" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateZoneAgingScript, opts)
+}
+
+// zoneAgingObjectScript defines the ConvertTo-ZoneAgingObject function
+// which maps the result of Get-DnsServerZoneAging to the json form of
+// a ZoneAging. The next scavenging run follows the last run of the server
+// by its scavenging interval, there is none while scavenging is disabled
+// or before it first ran
+const zoneAgingObjectScript = `
+	function ConvertTo-ZoneAgingObject($aging) {
+		$scavengeTime = ""
+		if ($null -ne $aging.AvailForScavengeTime) {
+			$scavengeTime = $aging.AvailForScavengeTime.ToUniversalTime().ToString("o")
+		}
+
+		$nextScavengeTime = ""
+		$scavenging = Get-DnsServerScavenging -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+		if ($null -ne $scavenging -and $scavenging.ScavengingState -and $null -ne $scavenging.LastScavengeTime -and
+			$scavenging.LastScavengeTime -gt [datetime]::MinValue -and $scavenging.ScavengingInterval.TotalSeconds -gt 0) {
+			$nextScavengeTime = $scavenging.LastScavengeTime.Add($scavenging.ScavengingInterval).ToUniversalTime().ToString("o")
+		}
+
+		return @{
+			name                    = $aging.ZoneName
+			aging_enabled           = [bool]$aging.AgingEnabled
+			no_refresh_interval     = [int]$aging.NoRefreshInterval.TotalHours
+			refresh_interval        = [int]$aging.RefreshInterval.TotalHours
+			scavenge_servers        = @($aging.ScavengeServers | ForEach-Object { "$_" })
+			avail_for_scavenge_time = $scavengeTime
+			next_scavenge_time      = $nextScavengeTime
+		}
+	}
+`
+
+const (
+	readZoneAgingScript = zoneAgingObjectScript + `
+	Import-Module DNSServer
+
+	$aging = Get-DnsServerZoneAging -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone aging found"
+		aging = ConvertTo-ZoneAgingObject $aging
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateZoneAgingScript = zoneAgingObjectScript + `
+	Import-Module DNSServer
+
+	$aging = Get-DnsServerZoneAging -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		Name              = "{{.Name}}"
+		ComputerName      = "{{.DnsServer}}"
+		Aging             = ${{.AgingEnabled}}
+		NoRefreshInterval = [System.TimeSpan]::FromHours({{.NoRefreshInterval}})
+		RefreshInterval   = [System.TimeSpan]::FromHours({{.RefreshInterval}})
+		ScavengeServers   = @({{range $i, $s := .ScavengeServers}}{{if $i}}, {{end}}"{{$s}}"{{end}})
+		PassThru          = $true
+		ErrorAction       = "SilentlyContinue"
+	}
+
+	$aging = Set-DnsServerZoneAging @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone aging updated"
+		aging = ConvertTo-ZoneAgingObject $aging
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
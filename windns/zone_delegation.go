@@ -0,0 +1,418 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadZoneDelegationOptions options to read a zone delegation
+type ReadZoneDelegationOptions struct {
+	DnsServer     string
+	ZoneName      string
+	ChildZoneName string
+}
+
+// AddZoneDelegationOptions options to add a zone delegation
+type AddZoneDelegationOptions struct {
+	DnsServer     string
+	ZoneName      string
+	ChildZoneName string
+	NameServers   []*DelegationNameServer
+}
+
+// UpdateZoneDelegationOptions options to update a zone delegation. Name
+// servers missing from NameServers are removed from the delegation
+type UpdateZoneDelegationOptions struct {
+	DnsServer     string
+	ZoneName      string
+	ChildZoneName string
+	NameServers   []*DelegationNameServer
+}
+
+// DeleteZoneDelegationOptions options to delete a zone delegation
+type DeleteZoneDelegationOptions struct {
+	DnsServer     string
+	ZoneName      string
+	ChildZoneName string
+}
+
+// ReadZoneDelegation reads the name servers and glue addresses of a zone
+// delegation
+func (c *Client) ReadZoneDelegation(opts *ReadZoneDelegationOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.ChildZoneName == "" {
+		return nil, fmt.Errorf(`required value "child_zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readZoneDelegationScript, opts)
+}
+
+// AddZoneDelegation delegates a child zone to its name servers
+func (c *Client) AddZoneDelegation(opts *AddZoneDelegationOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.ChildZoneName == "" {
+		return nil, fmt.Errorf(`required value "child_zone_name" not specified`)
+	}
+	if len(opts.NameServers) == 0 {
+		return nil, fmt.Errorf(`required value "name_servers" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addZoneDelegationScript, opts)
+}
+
+// UpdateZoneDelegation adds, updates and removes the name servers of a zone
+// delegation so that it matches NameServers
+func (c *Client) UpdateZoneDelegation(opts *UpdateZoneDelegationOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.ChildZoneName == "" {
+		return nil, fmt.Errorf(`required value "child_zone_name" not specified`)
+	}
+	if len(opts.NameServers) == 0 {
+		return nil, fmt.Errorf(`required value "name_servers" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateZoneDelegationScript, opts)
+}
+
+// DeleteZoneDelegation removes a zone delegation with all of its name servers
+// and glue records
+func (c *Client) DeleteZoneDelegation(opts *DeleteZoneDelegationOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.ChildZoneName == "" {
+		return nil, fmt.Errorf(`required value "child_zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteZoneDelegationScript, opts)
+}
+
+const (
+	// zoneDelegationObjectScript defines ConvertTo-DelegationObject which
+	// merges the per name server output of Get-DnsServerZoneDelegation into
+	// a single delegation and Get-DesiredNameServers which returns the
+	// requested name servers
+	zoneDelegationObjectScript = `
+	function ConvertTo-DelegationObject($delegations) {
+		$nameServers = @()
+		foreach ($delegation in $delegations) {
+			$addresses = @()
+			foreach ($glue in $delegation.IPAddress) {
+				if ($glue.RecordType -eq "AAAA") {
+					$addresses += $glue.RecordData.IPv6Address.IPAddressToString
+				}
+				elseif ($glue.RecordType -eq "A") {
+					$addresses += $glue.RecordData.IPv4Address.IPAddressToString
+				}
+			}
+
+			$nameServers += @{
+				name         = $delegation.NameServer.RecordData.NameServer
+				ip_addresses = $addresses
+			}
+		}
+
+		return @{
+			child_zone_name = "$(@($delegations)[0].ChildZoneName)"
+			name_servers    = $nameServers
+		}
+	}
+
+	function Get-DesiredNameServers {
+		return @(
+			{{range .NameServers}}
+			@{
+				name         = "{{.Name}}"
+				ip_addresses = @({{range $i, $ip := .IPAddresses}}{{if $i}}, {{end}}"{{$ip}}"{{end}})
+			}
+			{{end}}
+		)
+	}
+	`
+
+	readZoneDelegationScript = zoneDelegationObjectScript + `
+	Import-Module DNSServer
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $delegations) {
+		if ($null -eq $delegations -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone delegation not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone delegation found"
+		delegation = ConvertTo-DelegationObject $delegations
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addZoneDelegationScript = zoneDelegationObjectScript + `
+	Import-Module DNSServer
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $delegations) {
+		$res = @{
+			code = 400
+			detail = "zone delegation already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	foreach ($nameServer in Get-DesiredNameServers) {
+		$createArgs = @{
+			Name          = "{{.ZoneName}}"
+			ChildZoneName = "{{.ChildZoneName}}"
+			NameServer    = $nameServer.name
+			ComputerName  = "{{.DnsServer}}"
+			ErrorAction   = "SilentlyContinue"
+		}
+
+		if ($nameServer.ip_addresses.Count -gt 0) {
+			$createArgs.IPAddress = $nameServer.ip_addresses
+		}
+
+		Add-DnsServerZoneDelegation @createArgs
+		if ($Error.Count -gt 0) {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone delegation created"
+		delegation = ConvertTo-DelegationObject $delegations
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateZoneDelegationScript = zoneDelegationObjectScript + `
+	Import-Module DNSServer
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $delegations) {
+		if ($null -eq $delegations -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone delegation not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	# hashtable keys are case insensitive so name servers are matched
+	# ignoring case and the trailing dot
+	$current = @{}
+	foreach ($nameServer in (ConvertTo-DelegationObject $delegations).name_servers) {
+		$current[$nameServer.name.TrimEnd(".")] = $nameServer
+	}
+
+	$desired = @{}
+	foreach ($nameServer in Get-DesiredNameServers) {
+		$key = $nameServer.name.TrimEnd(".")
+		$desired[$key] = $true
+
+		$delegationArgs = @{
+			Name          = "{{.ZoneName}}"
+			ChildZoneName = "{{.ChildZoneName}}"
+			NameServer    = $nameServer.name
+			ComputerName  = "{{.DnsServer}}"
+			ErrorAction   = "SilentlyContinue"
+		}
+
+		if ($nameServer.ip_addresses.Count -gt 0) {
+			$delegationArgs.IPAddress = $nameServer.ip_addresses
+		}
+
+		$existing = $current[$key]
+		if ($null -eq $existing) {
+			Add-DnsServerZoneDelegation @delegationArgs
+		}
+		else {
+			$have = ($existing.ip_addresses | Sort-Object) -join ","
+			$want = ($nameServer.ip_addresses | ForEach-Object { ([ipaddress]$_).IPAddressToString } | Sort-Object) -join ","
+			if ($have -eq $want) {
+				continue
+			}
+
+			if ($nameServer.ip_addresses.Count -gt 0) {
+				Set-DnsServerZoneDelegation @delegationArgs
+			}
+			else {
+				# glue can not be cleared in place, re-add the name server
+				Remove-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -NameServer $existing.name -ComputerName "{{.DnsServer}}" -Force -ErrorAction "SilentlyContinue"
+				if ($Error.Count -eq 0) {
+					Add-DnsServerZoneDelegation @delegationArgs
+				}
+			}
+		}
+
+		if ($Error.Count -gt 0) {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	foreach ($key in @($current.Keys)) {
+		if ($desired.ContainsKey($key)) {
+			continue
+		}
+
+		Remove-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -NameServer $current[$key].name -ComputerName "{{.DnsServer}}" -Force -ErrorAction "SilentlyContinue"
+		if ($Error.Count -gt 0) {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone delegation updated"
+		delegation = ConvertTo-DelegationObject $delegations
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteZoneDelegationScript = zoneDelegationObjectScript + `
+	Import-Module DNSServer
+
+	$delegations = Get-DnsServerZoneDelegation -Name "{{.ZoneName}}" -ChildZoneName "{{.ChildZoneName}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $delegations) {
+		if ($null -eq $delegations -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone delegation not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$deleteArgs = @{
+		Name          = "{{.ZoneName}}"
+		ChildZoneName = "{{.ChildZoneName}}"
+		ComputerName  = "{{.DnsServer}}"
+		ErrorAction   = "SilentlyContinue"
+		Confirm       = $false
+		Force         = $true
+	}
+
+	Remove-DnsServerZoneDelegation @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone delegation deleted"
+		delegation = ConvertTo-DelegationObject $delegations
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
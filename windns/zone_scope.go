@@ -0,0 +1,231 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadZoneScopeOptions options to read a zone scope
+type ReadZoneScopeOptions struct {
+	DnsServer string
+	ZoneName  string
+	Name      string
+}
+
+// AddZoneScopeOptions options to add a zone scope
+type AddZoneScopeOptions struct {
+	DnsServer    string
+	ZoneName     string
+	Name         string
+	LoadExisting bool
+}
+
+// DeleteZoneScopeOptions options to delete a zone scope
+type DeleteZoneScopeOptions struct {
+	DnsServer string
+	ZoneName  string
+	Name      string
+}
+
+// ReadZoneScope reads a zone scope
+func (c *Client) ReadZoneScope(opts *ReadZoneScopeOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readZoneScopeScript, opts)
+}
+
+// AddZoneScope adds a new zone scope
+func (c *Client) AddZoneScope(opts *AddZoneScopeOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addZoneScopeScript, opts)
+}
+
+// DeleteZoneScope deletes a zone scope and all of its records
+func (c *Client) DeleteZoneScope(opts *DeleteZoneScopeOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteZoneScopeScript, opts)
+}
+
+const (
+	readZoneScopeScript = `
+	Import-Module DNSServer
+
+	$scope = Get-DnsServerZoneScope -ZoneName "{{.ZoneName}}" -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $scope) {
+		if ($null -eq $scope -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone scope not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$scopes = @()
+	$scopes += @{
+		name      = $scope.ZoneScope
+		file_name = "$($scope.FileName)"
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone scope found"
+		zone_scopes = $scopes
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addZoneScopeScript = `
+	Import-Module DNSServer
+
+	$scope = Get-DnsServerZoneScope -ZoneName "{{.ZoneName}}" -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$Error.Clear()
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($null -ne $scope) {
+		$res = @{
+			code = 400
+			detail = "zone scope already exists"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$createArgs = @{
+		ZoneName     = "{{.ZoneName}}"
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		LoadExisting = ${{.LoadExisting}}
+		PassThru     = $true
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	$scope = Add-DnsServerZoneScope @createArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$scopes = @()
+	$scopes += @{
+		name      = $scope.ZoneScope
+		file_name = "$($scope.FileName)"
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone scope created"
+		zone_scopes = $scopes
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteZoneScopeScript = `
+	Import-Module DNSServer
+
+	$scope = Get-DnsServerZoneScope -ZoneName "{{.ZoneName}}" -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $scope) {
+		if ($null -eq $scope -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone scope not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$deleteArgs = @{
+		ZoneName     = "{{.ZoneName}}"
+		Name         = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	Remove-DnsServerZoneScope @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$scopes = @()
+	$scopes += @{
+		name      = $scope.ZoneScope
+		file_name = "$($scope.FileName)"
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone scope deleted"
+		zone_scopes = $scopes
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,589 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReadZoneSigningOptions options to read the signing keys and dnssec
+// settings of a zone
+type ReadZoneSigningOptions struct {
+	DnsServer string
+	Name      string
+}
+
+// UpdateZoneDnsSecOptions options to update the dnssec settings of a zone,
+// the NSec3 settings are only applied when DenialOfExistence is NSec3
+type UpdateZoneDnsSecOptions struct {
+	DnsServer                string
+	Name                     string
+	DenialOfExistence        string
+	NSec3Iterations          int
+	NSec3OptOut              bool
+	NSec3RandomSaltLength    int
+	EnableRfc5011KeyRollover bool
+}
+
+// SignZoneOptions options to sign or unsign a zone
+type SignZoneOptions struct {
+	DnsServer string
+	Name      string
+}
+
+// AddSigningKeyOptions options to add a signing key, the rollover period
+// is in days and the server default is used when it is not set
+type AddSigningKeyOptions struct {
+	DnsServer          string
+	ZoneName           string
+	KeyType            string
+	CryptoAlgorithm    string
+	KeyLength          int
+	KeyStorageProvider string
+	RolloverPeriod     int
+}
+
+// UpdateSigningKeyOptions options to update the rollover period of a
+// signing key in days
+type UpdateSigningKeyOptions struct {
+	DnsServer      string
+	ZoneName       string
+	KeyId          string
+	RolloverPeriod int
+}
+
+// DeleteSigningKeyOptions options to delete a signing key
+type DeleteSigningKeyOptions struct {
+	DnsServer string
+	ZoneName  string
+	KeyId     string
+}
+
+// ReadZoneSigning reads the signing keys and dnssec settings of a zone
+func (c *Client) ReadZoneSigning(opts *ReadZoneSigningOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(readZoneSigningScript, opts)
+}
+
+// UpdateZoneDnsSec updates the denial of existence and key rollover
+// settings of a zone
+func (c *Client) UpdateZoneDnsSec(opts *UpdateZoneDnsSecOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.DenialOfExistence == "" {
+		return nil, fmt.Errorf(`required value "denial_of_existence" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateZoneDnsSecScript, opts)
+}
+
+// SignZone signs a zone with its signing keys, a zone that is already
+// signed is signed again so key changes take effect
+func (c *Client) SignZone(opts *SignZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(signZoneScript, opts)
+}
+
+// UnsignZone removes the dnssec signatures from a zone
+func (c *Client) UnsignZone(opts *SignZoneOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(unsignZoneScript, opts)
+}
+
+// AddSigningKey adds a key signing or zone signing key to a zone
+func (c *Client) AddSigningKey(opts *AddSigningKeyOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.KeyType == "" {
+		return nil, fmt.Errorf(`required value "key_type" not specified`)
+	}
+	if opts.CryptoAlgorithm == "" {
+		return nil, fmt.Errorf(`required value "crypto_algorithm" not specified`)
+	}
+	if opts.KeyStorageProvider == "" {
+		return nil, fmt.Errorf(`required value "key_storage_provider" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(addSigningKeyScript, opts)
+}
+
+// UpdateSigningKey updates the rollover period of a signing key
+func (c *Client) UpdateSigningKey(opts *UpdateSigningKeyOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.KeyId == "" {
+		return nil, fmt.Errorf(`required value "key_id" not specified`)
+	}
+	if opts.RolloverPeriod < 1 {
+		return nil, fmt.Errorf(`required value "rollover_period" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateSigningKeyScript, opts)
+}
+
+// DeleteSigningKey deletes a signing key from a zone
+func (c *Client) DeleteSigningKey(opts *DeleteSigningKeyOptions) (*Response, error) {
+	if opts.ZoneName == "" {
+		return nil, fmt.Errorf(`required value "zone_name" not specified`)
+	}
+	if opts.KeyId == "" {
+		return nil, fmt.Errorf(`required value "key_id" not specified`)
+	}
+
+	opts.ZoneName = strings.TrimSuffix(opts.ZoneName, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(deleteSigningKeyScript, opts)
+}
+
+// signingObjectScript defines the ConvertTo-SigningKeyObject and
+// ConvertTo-DnsSecObject functions which map signing keys and the dnssec
+// settings of a zone to the json form of a SigningKey and DnsSecSettings
+const signingObjectScript = zoneObjectScript + `
+	function ConvertTo-SigningKeyObject($key) {
+		$rollover = 0
+		if ($null -ne $key.RolloverPeriod) {
+			$rollover = [int]$key.RolloverPeriod.TotalDays
+		}
+
+		return @{
+			key_id                  = "$($key.KeyId)"
+			key_type                = "$($key.KeyType)"
+			crypto_algorithm        = "$($key.CryptoAlgorithm)"
+			key_length              = [int]$key.KeyLength
+			key_storage_provider    = "$($key.KeyStorageProvider)"
+			rollover_period         = $rollover
+			current_state           = "$($key.CurrentState)"
+			current_rollover_status = "$($key.CurrentRolloverStatus)"
+			last_rollover_time      = Format-ZoneTime $key.LastRolloverTime
+			next_rollover_time      = Format-ZoneTime $key.NextRolloverTime
+		}
+	}
+
+	function ConvertTo-DnsSecObject($zone, $settings) {
+		return @{
+			is_signed                   = [bool]$zone.IsSigned
+			denial_of_existence         = "$($settings.DenialOfExistence)"
+			nsec3_iterations            = [int]$settings.NSec3Iterations
+			nsec3_opt_out               = [bool]$settings.NSec3OptOut
+			nsec3_random_salt_length    = [int]$settings.NSec3RandomSaltLength
+			enable_rfc5011_key_rollover = [bool]$settings.EnableRfc5011KeyRollover
+		}
+	}
+`
+
+const (
+	readZoneSigningScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$settings = Get-DnsServerDnsSecZoneSetting -ZoneName "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	$key = Get-DnsServerSigningKey -ZoneName "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$keys = @()
+	$key | ForEach-Object {
+		$keys += ConvertTo-SigningKeyObject $_
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone signing found"
+		signing_keys = $keys
+		dnssec = ConvertTo-DnsSecObject $zone $settings
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateZoneDnsSecScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		ZoneName                 = "{{.Name}}"
+		ComputerName             = "{{.DnsServer}}"
+		DenialOfExistence        = "{{.DenialOfExistence}}"
+		EnableRfc5011KeyRollover = ${{.EnableRfc5011KeyRollover}}
+		PassThru                 = $true
+		ErrorAction              = "SilentlyContinue"
+	}
+
+	{{if eq .DenialOfExistence "NSec3"}}
+	$setArgs.NSec3Iterations = {{.NSec3Iterations}}
+	$setArgs.NSec3OptOut = ${{.NSec3OptOut}}
+	$setArgs.NSec3RandomSaltLength = {{.NSec3RandomSaltLength}}
+	{{end}}
+
+	$settings = Set-DnsServerDnsSecZoneSetting @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone dnssec settings updated"
+		dnssec = ConvertTo-DnsSecObject $zone $settings
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	signZoneScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$signArgs = @{
+		ZoneName     = "{{.Name}}"
+		ComputerName = "{{.DnsServer}}"
+		Force        = $true
+		ErrorAction  = "SilentlyContinue"
+	}
+
+	if ($zone.IsSigned) {
+		$signArgs.DoResign = $true
+	}
+
+	Invoke-DnsServerZoneSign @signArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	$settings = Get-DnsServerDnsSecZoneSetting -ZoneName "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone signed"
+		dnssec = ConvertTo-DnsSecObject $zone $settings
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	unsignZoneScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	if ($zone.IsSigned) {
+		Invoke-DnsServerZoneUnsign -ZoneName "{{.Name}}" -ComputerName "{{.DnsServer}}" -Force -ErrorAction "SilentlyContinue"
+		if ($Error.Count -gt 0) {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	$settings = Get-DnsServerDnsSecZoneSetting -ZoneName "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$res = @{
+		code = 200
+		detail = "zone unsigned"
+		dnssec = ConvertTo-DnsSecObject $zone $settings
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	addSigningKeyScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$addArgs = @{
+		ZoneName           = "{{.ZoneName}}"
+		ComputerName       = "{{.DnsServer}}"
+		Type               = "{{.KeyType}}"
+		CryptoAlgorithm    = "{{.CryptoAlgorithm}}"
+		KeyStorageProvider = "{{.KeyStorageProvider}}"
+		PassThru           = $true
+		ErrorAction        = "SilentlyContinue"
+	}
+
+	{{if .KeyLength}}
+	$addArgs.KeyLength = {{.KeyLength}}
+	{{end}}
+	{{if .RolloverPeriod}}
+	$addArgs.RolloverPeriod = [System.TimeSpan]::FromDays({{.RolloverPeriod}})
+	{{end}}
+
+	$key = Add-DnsServerSigningKey @addArgs
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$keys = @()
+	$keys += ConvertTo-SigningKeyObject $key
+
+	$res = @{
+		code = 200
+		detail = "signing key created"
+		signing_keys = $keys
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	updateSigningKeyScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$key = Get-DnsServerSigningKey -ZoneName "{{.ZoneName}}" -KeyId "{{.KeyId}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $key) {
+		if ($null -eq $key -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "signing key not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		ZoneName       = "{{.ZoneName}}"
+		KeyId          = "{{.KeyId}}"
+		ComputerName   = "{{.DnsServer}}"
+		RolloverPeriod = [System.TimeSpan]::FromDays({{.RolloverPeriod}})
+		PassThru       = $true
+		ErrorAction    = "SilentlyContinue"
+	}
+
+	$key = Set-DnsServerSigningKey @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$keys = @()
+	$keys += ConvertTo-SigningKeyObject $key
+
+	$res = @{
+		code = 200
+		detail = "signing key updated"
+		signing_keys = $keys
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+
+	deleteSigningKeyScript = signingObjectScript + `
+	Import-Module DNSServer
+
+	$key = Get-DnsServerSigningKey -ZoneName "{{.ZoneName}}" -KeyId "{{.KeyId}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0 -or $null -eq $key) {
+		if ($null -eq $key -or $Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "signing key not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$deleteArgs = @{
+		ZoneName     = "{{.ZoneName}}"
+		KeyId        = "{{.KeyId}}"
+		ComputerName = "{{.DnsServer}}"
+		ErrorAction  = "SilentlyContinue"
+		Confirm      = $false
+		Force        = $true
+	}
+
+	Remove-DnsServerSigningKey @deleteArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$keys = @()
+	$keys += ConvertTo-SigningKeyObject $key
+
+	$res = @{
+		code = 200
+		detail = "signing key deleted"
+		signing_keys = $keys
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`
+)
@@ -0,0 +1,102 @@
+package windns
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UpdateZoneTransferOptions options to update the zone transfer and notify
+// settings of a primary zone. SecondaryServers and NotifyServers replace the
+// server lists of the zone, empty lists clear them
+type UpdateZoneTransferOptions struct {
+	DnsServer         string
+	Name              string
+	SecureSecondaries string
+	SecondaryServers  []string
+	Notify            string
+	NotifyServers     []string
+}
+
+// UpdateZoneTransfer updates the zone transfer and notify settings of a
+// primary zone
+func (c *Client) UpdateZoneTransfer(opts *UpdateZoneTransferOptions) (*Response, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf(`required value "name" not specified`)
+	}
+	if opts.SecureSecondaries == "" {
+		return nil, fmt.Errorf(`required value "secure_secondaries" not specified`)
+	}
+	if opts.Notify == "" {
+		return nil, fmt.Errorf(`required value "notify" not specified`)
+	}
+
+	opts.Name = strings.TrimSuffix(opts.Name, ".")
+	opts.DnsServer = c.o.DnsServer
+	return c.run(updateZoneTransferScript, opts)
+}
+
+const updateZoneTransferScript = zoneObjectScript + `
+	Import-Module DNSServer
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		if ($Error[0].CategoryInfo.Category -eq "ObjectNotFound")
+		{
+			$res = @{
+				code = 404
+				detail = "zone not found"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+		else {
+			$res = @{
+				code = 500
+				detail = "$($Error[0].Exception.Message)"
+			}
+			Write-Output "$($res | ConvertTo-Json -Compress)"
+			return
+		}
+	}
+
+	$setArgs = @{
+		Name              = "{{.Name}}"
+		ComputerName      = "{{.DnsServer}}"
+		SecureSecondaries = "{{.SecureSecondaries}}"
+		SecondaryServers  = @({{range $i, $s := .SecondaryServers}}{{if $i}}, {{end}}"{{$s}}"{{end}})
+		Notify            = "{{.Notify}}"
+		NotifyServers     = @({{range $i, $s := .NotifyServers}}{{if $i}}, {{end}}"{{$s}}"{{end}})
+		ErrorAction       = "SilentlyContinue"
+	}
+
+	Set-DnsServerPrimaryZone @setArgs
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zone = Get-DnsServerZone -Name "{{.Name}}" -ComputerName "{{.DnsServer}}" -ErrorAction "SilentlyContinue"
+	if ($Error.Count -gt 0) {
+		$res = @{
+			code = 500
+			detail = "$($Error[0].Exception.Message)"
+		}
+		Write-Output "$($res | ConvertTo-Json -Compress)"
+		return
+	}
+
+	$zones = @()
+	$zones += ConvertTo-ZoneObject $zone
+
+	$res = @{
+		code = 200
+		detail = "zone updated"
+		zones = $zones
+	}
+
+	Write-Output "$($res | ConvertTo-Json -Compress -Depth 5)"
+	`